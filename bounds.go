@@ -0,0 +1,214 @@
+package validity
+
+import (
+	"math/big"
+)
+
+// The gt, gte, lt, lte and between_exclusive rules, which every numeric checker shares, and the String checker applies
+// to its length. Bounds are numbers, like "gt:0", or the names of other fields holding numbers, like "lte:max_price".
+// Comparisons are exact, so an Int is never rounded to compare it with a Float.
+type bound struct {
+	// The value being validated, or nil if it isn't a finite number, in which case every comparison fails.
+	item  *big.Rat
+	input map[string]interface{}
+}
+
+// Compares the item with the bound in the rule argument, returning -1, 0 or +1 as the item is smaller, equal or
+// larger. ok is false if the argument is neither a number nor a field holding one.
+func (b bound) compare(arg string) (cmp int, ok bool) {
+	if b.item == nil {
+		return 0, false
+	}
+
+	other, ok := b.resolve(arg)
+	if !ok {
+		return 0, false
+	}
+
+	return b.item.Cmp(other), true
+}
+
+// Converts a rule argument to a number, reading it from another field if it isn't one itself.
+func (b bound) resolve(arg string) (*big.Rat, bool) {
+	if out, ok := decimalToRat(arg); ok {
+		return out, true
+	}
+
+	value, exists := lookupPath(b.input, arg)
+	if !exists {
+		return nil, false
+	}
+
+	return parseDecimal(value)
+}
+
+func (b bound) gt(arg string) bool {
+	cmp, ok := b.compare(arg)
+
+	return ok && cmp > 0
+}
+
+func (b bound) gte(arg string) bool {
+	cmp, ok := b.compare(arg)
+
+	return ok && cmp >= 0
+}
+
+func (b bound) lt(arg string) bool {
+	cmp, ok := b.compare(arg)
+
+	return ok && cmp < 0
+}
+
+func (b bound) lte(arg string) bool {
+	cmp, ok := b.compare(arg)
+
+	return ok && cmp <= 0
+}
+
+func (b bound) betweenExclusive(min string, max string) bool {
+	return b.gt(min) && b.lt(max)
+}
+
+// Returns whether the item is base plus a whole multiple of step. The step can't be zero.
+func (b bound) step(step string, base string) bool {
+	size, ok := b.resolve(step)
+	if !ok || size.Sign() == 0 {
+		return false
+	}
+
+	offset, ok := b.resolve(base)
+	if b.item == nil || !ok {
+		return false
+	}
+
+	return new(big.Rat).Quo(new(big.Rat).Sub(b.item, offset), size).IsInt()
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v IntValidityChecker) bound() bound {
+	return bound{item: new(big.Rat).SetInt64(v.Item), input: v.Input}
+}
+
+func (v IntValidityChecker) ValidateBetweenExclusive(min string, max string) bool {
+	return v.bound().betweenExclusive(min, max)
+}
+
+func (v IntValidityChecker) ValidateGt(other string) bool {
+	return v.bound().gt(other)
+}
+
+func (v IntValidityChecker) ValidateGte(other string) bool {
+	return v.bound().gte(other)
+}
+
+func (v IntValidityChecker) ValidateLt(other string) bool {
+	return v.bound().lt(other)
+}
+
+func (v IntValidityChecker) ValidateLte(other string) bool {
+	return v.bound().lte(other)
+}
+
+func (v UintValidityChecker) bound() bound {
+	return bound{item: new(big.Rat).SetInt(new(big.Int).SetUint64(v.Item)), input: v.Input}
+}
+
+func (v UintValidityChecker) ValidateBetweenExclusive(min string, max string) bool {
+	return v.bound().betweenExclusive(min, max)
+}
+
+func (v UintValidityChecker) ValidateGt(other string) bool {
+	return v.bound().gt(other)
+}
+
+func (v UintValidityChecker) ValidateGte(other string) bool {
+	return v.bound().gte(other)
+}
+
+func (v UintValidityChecker) ValidateLt(other string) bool {
+	return v.bound().lt(other)
+}
+
+func (v UintValidityChecker) ValidateLte(other string) bool {
+	return v.bound().lte(other)
+}
+
+// Floats are compared by their shortest decimal form, so 0.1 passes "gte:0.1", even though the float is a little over.
+func (v FloatValidityChecker) bound() bound {
+	item, _ := parseDecimal(v.Item)
+
+	return bound{item: item, input: v.Input}
+}
+
+func (v FloatValidityChecker) ValidateBetweenExclusive(min string, max string) bool {
+	return v.bound().betweenExclusive(min, max)
+}
+
+func (v FloatValidityChecker) ValidateGt(other string) bool {
+	return v.bound().gt(other)
+}
+
+func (v FloatValidityChecker) ValidateGte(other string) bool {
+	return v.bound().gte(other)
+}
+
+func (v FloatValidityChecker) ValidateLt(other string) bool {
+	return v.bound().lt(other)
+}
+
+func (v FloatValidityChecker) ValidateLte(other string) bool {
+	return v.bound().lte(other)
+}
+
+func (v DecimalValidityChecker) bound() bound {
+	return bound{item: v.Item, input: v.Input}
+}
+
+func (v DecimalValidityChecker) ValidateBetweenExclusive(min string, max string) bool {
+	return v.bound().betweenExclusive(min, max)
+}
+
+func (v DecimalValidityChecker) ValidateGt(other string) bool {
+	return v.bound().gt(other)
+}
+
+func (v DecimalValidityChecker) ValidateGte(other string) bool {
+	return v.bound().gte(other)
+}
+
+func (v DecimalValidityChecker) ValidateLt(other string) bool {
+	return v.bound().lt(other)
+}
+
+func (v DecimalValidityChecker) ValidateLte(other string) bool {
+	return v.bound().lte(other)
+}
+
+// Strings compare their length, in the unit given by "length_unit".
+func (v StringValidityChecker) bound() bound {
+	return bound{item: new(big.Rat).SetInt64(int64(v.length())), input: v.Input}
+}
+
+func (v StringValidityChecker) ValidateBetweenExclusive(min string, max string) bool {
+	return v.bound().betweenExclusive(min, max)
+}
+
+func (v StringValidityChecker) ValidateGt(other string) bool {
+	return v.bound().gt(other)
+}
+
+func (v StringValidityChecker) ValidateGte(other string) bool {
+	return v.bound().gte(other)
+}
+
+func (v StringValidityChecker) ValidateLt(other string) bool {
+	return v.bound().lt(other)
+}
+
+func (v StringValidityChecker) ValidateLte(other string) bool {
+	return v.bound().lte(other)
+}
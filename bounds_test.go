@@ -0,0 +1,316 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestBoundsInclusive(t *testing.T) {
+	data := map[string]interface{}{"int": 5, "uint": 5, "float": 5.0, "decimal": "5", "string": "abcde"}
+
+	for _, rule := range []string{"min:5", "max:5", "between:5,5", "between:1,5", "between:5,9"} {
+		rules := ValidationRules{}
+		for key, kind := range map[string]string{"int": "Int", "uint": "Uint", "float": "Float", "decimal": "Decimal",
+			"string": "String"} {
+			rules[key] = []string{kind, rule}
+		}
+
+		if results := ValidateMap(data, rules); !results.IsValid {
+			t.Errorf("The %s rule should include its bounds for every type. Errors: %v", rule, results.Errors)
+		}
+	}
+}
+
+
+
+func TestBoundsValidateGtPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 6}
+		rules := ValidationRules{"foo": []string{kind, "gt:5"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s gt validator does not pass.", kind)
+		}
+	}
+}
+func TestBoundsValidateGtFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "gt:5"}}
+
+		for _, value := range []interface{}{5, 4} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s gt validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateGtFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 5.01}
+		rules := ValidationRules{"foo": []string{kind, "gt:5"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s gt validator does not pass.", kind)
+		}
+	}
+}
+func TestBoundsValidateGtFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "gt:5"}}
+
+		for _, value := range []interface{}{5.0, 4.99} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s gt validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateGtArgumentPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 1}
+		rules := ValidationRules{"foo": []string{kind, "gt:-1"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s gt validator does not pass.", kind)
+		}
+	}
+}
+func TestBoundsValidateGtArgumentFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 1}
+		rules := ValidationRules{"foo": []string{kind, "gt:nope"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s gt validator does not fail an argument which isn't a number.", kind)
+		}
+	}
+}
+
+
+
+func TestBoundsValidateGtePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "gte:5"}}
+
+		for _, value := range []interface{}{5, 6} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s gte validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateGteFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 4}
+		rules := ValidationRules{"foo": []string{kind, "gte:5"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s gte validator does not fail.", kind)
+		}
+	}
+}
+func TestBoundsValidateGteFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "gte:5"}}
+
+		for _, value := range []interface{}{5.0, 5.01} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s gte validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateGteFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 4.99}
+		rules := ValidationRules{"foo": []string{kind, "gte:5"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s gte validator does not fail.", kind)
+		}
+	}
+}
+
+
+
+func TestBoundsValidateLtPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "lt:5"}}
+
+		for _, value := range []interface{}{4, -10} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s lt validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateLtFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "lt:5"}}
+
+		for _, value := range []interface{}{5, 6} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s lt validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateLtFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 4.99}
+		rules := ValidationRules{"foo": []string{kind, "lt:5"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s lt validator does not pass.", kind)
+		}
+	}
+}
+func TestBoundsValidateLtFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "lt:5"}}
+
+		for _, value := range []interface{}{5.0, 5.01} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s lt validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+
+
+
+func TestBoundsValidateLtePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "lte:0.1"}}
+
+		for _, value := range []interface{}{0, -1} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s lte validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateLteFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 1}
+		rules := ValidationRules{"foo": []string{kind, "lte:0.1"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s lte validator does not fail.", kind)
+		}
+	}
+}
+func TestBoundsValidateLteFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 0.1}
+		rules := ValidationRules{"foo": []string{kind, "lte:0.1"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s lte validator does not pass.", kind)
+		}
+	}
+}
+func TestBoundsValidateLteFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 0.11}
+		rules := ValidationRules{"foo": []string{kind, "lte:0.1"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s lte validator does not fail.", kind)
+		}
+	}
+}
+
+
+
+func TestBoundsValidateBetweenExclusivePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "between_exclusive:1,5"}}
+
+		for _, value := range []interface{}{2, 4} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s between_exclusive validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateBetweenExclusiveFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "between_exclusive:1,5"}}
+
+		for _, value := range []interface{}{1, 5, 0, 6} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s between_exclusive validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateBetweenExclusiveFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "between_exclusive:1,5"}}
+
+		for _, value := range []interface{}{1.01, 4.5} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s between_exclusive validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestBoundsValidateBetweenExclusiveFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "between_exclusive:1,5"}}
+
+		for _, value := range []interface{}{0.99, 5.0} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s between_exclusive validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+
+
+
+func TestBoundsOtherFields(t *testing.T) {
+	data := map[string]interface{}{
+		"min_price": 10,
+		"max_price": "99.99",
+		"price":     50.5,
+		"low":       9,
+		"limits":    map[string]interface{}{"name": 4},
+		"name":      "abcd",
+		"big":       uint64(18446744073709551615),
+	}
+	rules := ValidationRules{
+		"price": []string{"Float", "gte:min_price", "lt:max_price", "between_exclusive:min_price,max_price"},
+		"low":   []string{"Int", "gt:min_price"},
+		"name":  []string{"String", "lte:limits.name", "gt:missing"},
+		"big":   []string{"Uint64", "gt:max_price"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["price"]) != 0 || len(results.Errors["low"]) != 1 || len(results.Errors["big"]) != 0 ||
+		len(results.Errors["name"]) != 1 || results.Errors["name"][0] != "Gt" {
+		t.Errorf("Bounds do not compare against other fields. Errors: %v", results.Errors)
+	}
+}
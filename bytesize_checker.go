@@ -0,0 +1,115 @@
+package validity
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// Checks sizes in bytes, for the ByteSize type. Values are numbers of bytes, or strings with SI units like "1.5GB" or
+// IEC units like "512MiB". Units are case insensitive, so "kb" is 1000 bytes, never kilobits. The item is the size in
+// bytes, as a uint64, which is what ends up in the results Data. Rule arguments are sizes too, like "max:512MiB".
+type ByteSizeValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  uint64
+}
+
+var byteSizePattern = regexp.MustCompile(`^(\d*\.?\d*)\s*([a-zA-Z]*)$`)
+
+// Bytes in each unit, by its lowercased name.
+var byteSizeUnits = map[string]int64{
+	"": 1, "b": 1,
+	"kb": 1e3, "mb": 1e6, "gb": 1e9, "tb": 1e12, "pb": 1e15, "eb": 1e18,
+	"kib": 1 << 10, "mib": 1 << 20, "gib": 1 << 30, "tib": 1 << 40, "pib": 1 << 50, "eib": 1 << 60,
+}
+
+// Parses the size into bytes. It must come to a whole number of bytes which fits in a uint64.
+func parseByteSize(s string) (uint64, bool) {
+	match := byteSizePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return 0, false
+	}
+
+	unit, ok := byteSizeUnits[strings.ToLower(match[2])]
+	if !ok {
+		return 0, false
+	}
+
+	value, ok := decimalToRat(match[1])
+	if !ok {
+		return 0, false
+	}
+
+	value.Mul(value, new(big.Rat).SetInt64(unit))
+	if !value.IsInt() || !value.Num().IsUint64() {
+		return 0, false
+	}
+
+	return value.Num().Uint64(), true
+}
+
+// Converts the value to a size in bytes. Numbers are taken as bytes already, and must be whole, so a float64 from
+// encoding/json works. Anything else is parsed as a string.
+func toByteSize(value interface{}) (uint64, bool) {
+	val := reflect.ValueOf(value)
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr, reflect.Float32, reflect.Float64:
+		out, err := toInteger(value)
+		if err != nil || !out.IsUint64() {
+			return 0, false
+		}
+		return out.Uint64(), true
+	case reflect.String:
+		return parseByteSize(val.String())
+	case reflect.Invalid:
+		return 0, false
+	}
+
+	return parseByteSize(fmt.Sprintf("%v", value))
+}
+
+// Converts a rule argument to a size. Arguments which can't be parsed aren't ok, and fail the rule.
+func (v ByteSizeValidityChecker) toSize(s string) (uint64, bool) {
+	return parseByteSize(s)
+}
+
+func (v ByteSizeValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v ByteSizeValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v ByteSizeValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v ByteSizeValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v ByteSizeValidityChecker) ValidateBetween(min string, max string) bool {
+	return v.ValidateMin(min) && v.ValidateMax(max)
+}
+
+func (v ByteSizeValidityChecker) ValidateMax(max string) bool {
+	size, ok := v.toSize(max)
+
+	return ok && v.Item <= size
+}
+
+func (v ByteSizeValidityChecker) ValidateMin(min string) bool {
+	size, ok := v.toSize(min)
+
+	return ok && v.Item >= size
+}
@@ -0,0 +1,82 @@
+package validity
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseByteSize(t *testing.T) {
+	pass := map[string]uint64{
+		"512":                  512,
+		"512B":                 512,
+		"1kB":                  1000,
+		"1KB":                  1000,
+		"1.5GB":                1500000000,
+		"512MiB":               512 << 20,
+		"1.5KiB":               1536,
+		"2 gib":                2 << 30,
+		"15EiB":                15 << 60,
+		"18446744073709551615": 18446744073709551615,
+	}
+
+	for input, expected := range pass {
+		if actual, ok := parseByteSize(input); !ok || actual != expected {
+			t.Errorf("%q should parse to %d, got %d.", input, expected, actual)
+		}
+	}
+
+	for _, input := range []string{"", "MB", "1.5B", "-1KB", "1XB", "1 M B", "0.0001kB", "16EiB"} {
+		if actual, ok := parseByteSize(input); ok {
+			t.Errorf("%q should not parse as a byte size, got %d.", input, actual)
+		}
+	}
+}
+
+
+
+func TestByteSizeType(t *testing.T) {
+	data := map[string]interface{}{"limit": "512MiB", "upload": 2048, "big": "2GB", "bad": -5}
+	rules := ValidationRules{
+		"limit":  []string{"ByteSize", "min:1MiB", "max:1GiB"},
+		"upload": []string{"ByteSize", "between:1KiB,2KiB"},
+		"big":    []string{"ByteSize", "max:1GiB"},
+		"bad":    []string{"ByteSize"},
+	}
+
+	results := ValidateMap(data, rules)
+	if results.Data["limit"] != uint64(512<<20) || results.Data["upload"] != uint64(2048) ||
+		len(results.Errors["big"]) != 1 || results.Errors["big"][0] != "Max" ||
+		len(results.Errors["bad"]) != 1 || results.Errors["bad"][0] != "ByteSize" {
+		t.Errorf("ByteSize type does not validate. Results: %v", results)
+	}
+}
+
+
+
+func TestByteSizeTypeJSON(t *testing.T) {
+	var data map[string]interface{}
+	json.Unmarshal([]byte(`{"limit": 1048576, "half": 0.5, "huge": 1e20}`), &data)
+	rules := ValidationRules{
+		"limit": []string{"ByteSize", "max:1MiB"},
+		"half":  []string{"ByteSize"},
+		"huge":  []string{"ByteSize"},
+	}
+
+	results := ValidateMap(data, rules)
+	if results.Data["limit"] != uint64(1<<20) || len(results.Errors["limit"]) != 0 ||
+		len(results.Errors["half"]) != 1 || len(results.Errors["huge"]) != 1 {
+		t.Errorf("ByteSize type does not read numbers from JSON. Results: %v", results)
+	}
+}
+
+
+
+func TestByteSizeValidateBadArgument(t *testing.T) {
+	data := map[string]interface{}{"limit": "1KB"}
+	rules := ValidationRules{"limit": []string{"ByteSize", "min:1GiBB"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("ByteSize validator does not fail on an argument that isn't a size.")
+	}
+}
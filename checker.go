@@ -25,19 +25,17 @@ func GetCheckerErrors(rules []string, instance ValidityChecker) []string {
 	errors := []string{}
 
 	for _, rule := range rules {
-		// First we want to split the rule into its method and arguments parts,
-		// so we have a []string{"rule", "arg1,arg2"}.
-		parts  := strings.SplitN(rule, ":", 2)
-		method := snakeToStudly(strings.ToLower(parts[0]))
+		method, args := parseRule(rule)
+
+		// Rules like "required" or filters are dealt with by the queue before the checker is run, so skip them.
+		if isQueueRule(method) {
+			continue
+		}
+
 		// The parameters to call is a list of reflection values.
 		params := []reflect.Value{}
-
-		// If we do have arguments (some rules do not require them), the split the arguments part by commas and
-		// put their Values into the params array.
-		if len(parts) > 1 {
-			for _, arg := range strings.Split(parts[1], ",") {
-				params = append(params, reflect.ValueOf(strings.Trim(arg, " ")))
-			}
+		for _, arg := range args {
+			params = append(params, reflect.ValueOf(arg))
 		}
 
 		// Finall, call the validator...
@@ -52,3 +50,21 @@ func GetCheckerErrors(rules []string, instance ValidityChecker) []string {
 	// And finally return any errors which occured.
 	return errors
 }
+
+// Splits a rule into its StudlyCased method name and its arguments, so "digits_between: 2, 4" gives "DigitsBetween"
+// and []string{"2", "4"}. Surrounding spaces are trimmed from the arguments.
+func parseRule(rule string) (method string, args []string) {
+	// First we want to split the rule into its method and arguments parts,
+	// so we have a []string{"rule", "arg1,arg2"}.
+	parts  := strings.SplitN(rule, ":", 2)
+	method  = snakeToStudly(strings.ToLower(strings.Trim(parts[0], " ")))
+
+	// If we do have arguments (some rules do not require them), the split the arguments part by commas.
+	if len(parts) > 1 {
+		for _, arg := range strings.Split(parts[1], ",") {
+			args = append(args, strings.Trim(arg, " "))
+		}
+	}
+
+	return method, args
+}
@@ -0,0 +1,207 @@
+package validity
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Identifiers with check digits, like card numbers and IBANs. These are rules on the StringValidityChecker. Each of
+// them implies filters (see impliedFilters) so that spaces and hyphens are stripped before validation, and the
+// compact form is what ends up in the results Data.
+
+var (
+	bicExpression  = regexp.MustCompile(`^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}(?:[A-Z0-9]{3})?$`)
+	isinExpression = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{9}[0-9]$`)
+	ibanExpression = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+)
+
+// IBAN lengths per country, from the SWIFT IBAN registry.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27, "BR": 29,
+	"BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28, "EE": 20, "EG": 29,
+	"ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20, "LB": 28,
+	"LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25, "MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20,
+	"MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18, "NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25,
+	"QA": 29, "RO": 24, "RS": 22, "RU": 33, "SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27,
+	"SO": 23, "ST": 25, "SV": 28, "TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20, "YE": 30,
+}
+
+// A card brand, with the ranges of leading digits (inclusive, and all the same length) and the lengths it issues.
+type cardBrand struct {
+	name    string
+	ranges  [][2]string
+	lengths []int
+}
+
+// Card brands, in the order they are checked. Discover comes before UnionPay as they share part of the 62 range.
+var cardBrands = []cardBrand{
+	{"amex", [][2]string{{"34", "34"}, {"37", "37"}}, []int{15}},
+	{"diners", [][2]string{{"300", "305"}, {"36", "36"}, {"38", "39"}}, []int{14, 15, 16, 17, 18, 19}},
+	{"discover", [][2]string{{"6011", "6011"}, {"644", "649"}, {"65", "65"}, {"622126", "622925"}}, []int{16, 17, 18, 19}},
+	{"jcb", [][2]string{{"3528", "3589"}}, []int{16, 17, 18, 19}},
+	{"maestro", [][2]string{{"5018", "5018"}, {"5020", "5020"}, {"5038", "5038"}, {"5893", "5893"}, {"6304", "6304"},
+		{"6759", "6759"}, {"6761", "6763"}}, []int{12, 13, 14, 15, 16, 17, 18, 19}},
+	{"mastercard", [][2]string{{"51", "55"}, {"2221", "2720"}}, []int{16}},
+	{"unionpay", [][2]string{{"62", "62"}}, []int{16, 17, 18, 19}},
+	{"visa", [][2]string{{"4", "4"}}, []int{13, 16, 19}},
+}
+
+// Returns whether the string is entirely ASCII digits, and not empty.
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// Returns whether the digits pass the Luhn check, as used by cards and ISINs.
+func luhnValid(digits string) bool {
+	sum    := 0
+	double := false
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	return sum % 10 == 0
+}
+
+// Returns whether the digits pass the GS1 check used by EAN, UPC and ISBN-13: alternating weights of 3 and 1 from
+// the right, including the check digit, summing to a multiple of 10.
+func gtinValid(digits string) bool {
+	sum := 0
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits) - 1 - i) % 2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return sum % 10 == 0
+}
+
+// Detects the brand of a card number, returning "" if it is not known.
+func detectCardBrand(number string) string {
+	for _, brand := range cardBrands {
+		for _, r := range brand.ranges {
+			width := len(r[0])
+			if len(number) >= width && number[:width] >= r[0] && number[:width] <= r[1] {
+				for _, length := range brand.lengths {
+					if len(number) == length {
+						return brand.name
+					}
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// Replaces letters with their numeric values (A = 10 ... Z = 35), as IBAN and ISIN checks need.
+func lettersToDigits(s string) string {
+	out := ""
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			out += strconv.Itoa(int(c - 'A' + 10))
+		} else {
+			out += string(c)
+		}
+	}
+
+	return out
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes for card numbers with a valid Luhn check digit. If brands are given, like "credit_card:visa,mastercard", the
+// number must be one of those brands. Known brands are amex, diners, discover, jcb, maestro, mastercard, unionpay
+// and visa.
+func (v StringValidityChecker) ValidateCreditCard(brands ...string) bool {
+	if !isDigits(v.Item) || len(v.Item) < 12 || len(v.Item) > 19 || !luhnValid(v.Item) {
+		return false
+	}
+	if len(brands) == 0 {
+		return true
+	}
+
+	brand := detectCardBrand(v.Item)
+	for _, allowed := range brands {
+		if brand != "" && strings.EqualFold(brand, allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes for IBANs of the right length for their country, with valid mod-97 check digits.
+func (v StringValidityChecker) ValidateIban() bool {
+	if !ibanExpression.MatchString(v.Item) || ibanLengths[v.Item[:2]] != len(v.Item) {
+		return false
+	}
+
+	number, ok := new(big.Int).SetString(lettersToDigits(v.Item[4:] + v.Item[:4]), 10)
+
+	return ok && new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+// Passes for BIC (SWIFT) codes of 8 or 11 characters.
+func (v StringValidityChecker) ValidateBic() bool {
+	return bicExpression.MatchString(v.Item)
+}
+
+// Passes for ISBN-10s, whose last character may be an X.
+func (v StringValidityChecker) ValidateIsbn10() bool {
+	if len(v.Item) != 10 || !isDigits(v.Item[:9]) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		digit := int(v.Item[i] - '0')
+		if i == 9 && v.Item[i] == 'X' {
+			digit = 10
+		} else if v.Item[i] < '0' || v.Item[i] > '9' {
+			return false
+		}
+		sum += digit * (10 - i)
+	}
+
+	return sum % 11 == 0
+}
+
+// Passes for ISBN-13s, which start with 978 or 979.
+func (v StringValidityChecker) ValidateIsbn13() bool {
+	return v.ValidateEan13() && (strings.HasPrefix(v.Item, "978") || strings.HasPrefix(v.Item, "979"))
+}
+
+func (v StringValidityChecker) ValidateEan8() bool {
+	return len(v.Item) == 8 && isDigits(v.Item) && gtinValid(v.Item)
+}
+
+func (v StringValidityChecker) ValidateEan13() bool {
+	return len(v.Item) == 13 && isDigits(v.Item) && gtinValid(v.Item)
+}
+
+// Passes for 12 digit UPC-A codes.
+func (v StringValidityChecker) ValidateUpc() bool {
+	return len(v.Item) == 12 && isDigits(v.Item) && gtinValid(v.Item)
+}
+
+// Passes for ISINs: a two letter country, nine alphanumeric characters and a Luhn check digit.
+func (v StringValidityChecker) ValidateIsin() bool {
+	return isinExpression.MatchString(v.Item) && luhnValid(lettersToDigits(v.Item))
+}
@@ -0,0 +1,258 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestStringValidateCreditCardPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "credit_card"}}
+
+	for _, value := range []string{
+		"4111111111111111", "4111 1111 1111 1111", "5555-5555-5555-4444", "378282246310005",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String credit_card validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCreditCardFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "credit_card"}}
+
+	for _, value := range []string{"4111111111111112", "41111111111", "4111a11111111111"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String credit_card validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateCreditCardBrandsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "credit_card:visa,mastercard"}}
+
+	for _, value := range []string{"4111111111111111", "5555555555554444", "2223003122003222"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String credit_card validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCreditCardBrandsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "credit_card:visa,mastercard"}}
+
+	for _, value := range []string{"378282246310005", "6011111111111117"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String credit_card validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateCreditCardBrandsAmexPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "credit_card:amex"}}
+
+	for _, value := range []string{"378282246310005", "371449635398431"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String credit_card validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCreditCardBrandsAmexFail(t *testing.T) {
+	data := TestStruct{Foo: "4111111111111111"}
+	rules := ValidationRules{"Foo": []string{"String", "credit_card:amex"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String credit_card validator does not fail.")
+	}
+}
+func TestStringCreditCardNormalised(t *testing.T) {
+	data := TestStruct{Foo: " 4111 1111-1111 1111 "}
+	rules := ValidationRules{"Foo": []string{"String", "credit_card"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != "4111111111111111" {
+		t.Errorf("Credit card numbers are not normalised. Results: %v", results)
+	}
+}
+
+
+
+func TestStringValidateIbanPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "iban"}}
+
+	for _, value := range []string{"GB82 WEST 1234 5698 7654 32", "DE89370400440532013000", "nl91abna0417164300"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String iban validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateIbanFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "iban"}}
+
+	for _, value := range []string{"GB82WEST12345698765433", "GB82WEST123456987654", "ZZ82WEST12345698765432"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String iban validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringIbanNormalised(t *testing.T) {
+	data := TestStruct{Foo: "gb82 west 1234 5698 7654 32"}
+	rules := ValidationRules{"Foo": []string{"String", "iban"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != "GB82WEST12345698765432" {
+		t.Errorf("IBANs are not normalised. Results: %v", results)
+	}
+}
+
+
+
+func TestStringValidateBicPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "bic"}}
+
+	for _, value := range []string{"DEUTDEFF", "DEUTDEFF500", "deutdeff"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String bic validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateBicFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "bic"}}
+
+	for _, value := range []string{"DEUTDEF", "DEUTDEFF50", "1EUTDEFF"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String bic validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateIsbn10Pass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isbn10"}}
+
+	for _, value := range []string{"0306406152", "0-8044-2957-X", "080442957x"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String isbn10 validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateIsbn10Fail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isbn10"}}
+
+	for _, value := range []string{"0306406153", "030640615", "X306406152"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String isbn10 validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateIsbn13Pass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isbn13"}}
+
+	for _, value := range []string{"9780306406157", "978-0-306-40615-7"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String isbn13 validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateIsbn13Fail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isbn13"}}
+
+	for _, value := range []string{"9780306406158", "4006381333931"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String isbn13 validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateEan8Pass(t *testing.T) {
+	data := TestStruct{Foo: "96385074"}
+	rules := ValidationRules{"Foo": []string{"String", "ean8"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String ean8 validator does not pass.")
+	}
+}
+func TestStringValidateEan8Fail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "ean8"}}
+
+	for _, value := range []string{"96385075", "9638507"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String ean8 validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateEan13Pass(t *testing.T) {
+	data := TestStruct{Foo: "4006381333931"}
+	rules := ValidationRules{"Foo": []string{"String", "ean13"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String ean13 validator does not pass.")
+	}
+}
+func TestStringValidateEan13Fail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "ean13"}}
+
+	for _, value := range []string{"4006381333932", "400638133393"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String ean13 validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateUpcPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "upc"}}
+
+	for _, value := range []string{"036000291452", "0 36000 29145 2"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String upc validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateUpcFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "upc"}}
+
+	for _, value := range []string{"036000291453", "36000291452"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String upc validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateIsinPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isin"}}
+
+	for _, value := range []string{"US0378331005", "GB0002634946", "us0378331005"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String isin validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateIsinFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "isin"}}
+
+	for _, value := range []string{"US0378331006", "US037833100", "1S0378331005"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String isin validator does not fail %q.", value)
+		}
+	}
+}
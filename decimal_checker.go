@@ -0,0 +1,227 @@
+package validity
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Checks exact decimal numbers, for the Decimal type, which suits amounts of money. The item is a *big.Rat, which is
+// also what ends up in the results Data, so nothing is lost to floating point. Use FloatString to format it, like
+// item.FloatString(2).
+type DecimalValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  *big.Rat
+	// Places is the fewest decimal places needed to write the item, so 19.90 has one.
+	Places int
+	// Input is the whole of the data under validation, for rules which depend on other fields.
+	Input map[string]interface{}
+}
+
+// Exponents beyond this are rejected, rather than building enormous numbers from inputs like "1e1000000000". Numbers
+// with more digits than maxDecimalDigits are rejected too, as big.Rat arithmetic on them gets slow.
+const (
+	maxDecimalExponent = 1000
+	maxDecimalDigits   = 1000
+)
+
+// Converts the value to an exact decimal. Integers are converted directly, and floats by their shortest decimal form,
+// so the float64 0.1 becomes exactly 1/10. Strings and json.Number must be in decimal notation, like "19.99" or
+// "1.5e3".
+func parseDecimal(value interface{}) (*big.Rat, bool) {
+	out, _, ok := parseDecimalPlaces(value)
+
+	return out, ok
+}
+
+// Converts the value like parseDecimal, also returning the fewest decimal places needed to write it.
+func parseDecimalPlaces(value interface{}) (*big.Rat, int, bool) {
+	val := reflect.ValueOf(value)
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(val.Int()), 0, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(val.Uint())), 0, true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(val.Float()) || math.IsInf(val.Float(), 0) {
+			return nil, 0, false
+		}
+		return decimalToRatPlaces(strconv.FormatFloat(val.Float(), 'g', -1, val.Type().Bits()))
+	case reflect.String:
+		return decimalToRatPlaces(val.String())
+	case reflect.Invalid:
+		return nil, 0, false
+	}
+
+	return decimalToRatPlaces(fmt.Sprintf("%v", value))
+}
+
+// Converts decimal notation to a rational, by reading the digits as an integer and scaling it by the exponent.
+func decimalToRat(s string) (*big.Rat, bool) {
+	out, _, ok := decimalToRatPlaces(s)
+
+	return out, ok
+}
+
+// Converts decimal notation like decimalToRat, also returning the fewest decimal places needed to write it. These are
+// counted from the digits and exponent as written, ignoring trailing zeros, so "19.90" and "1.99e1" have one.
+func decimalToRatPlaces(s string) (*big.Rat, int, bool) {
+	match := decimalPattern.FindStringSubmatch(s)
+	if match == nil || match[2] == "" && match[3] == "" || len(match[2]) + len(match[3]) > maxDecimalDigits {
+		return nil, 0, false
+	}
+
+	digits, ok := new(big.Int).SetString(match[1] + match[2] + match[3], 10)
+	if !ok {
+		return nil, 0, false
+	}
+
+	exponent := -len(match[3])
+	if match[4] != "" {
+		shift, err := strconv.Atoi(match[4])
+		if err != nil || shift > maxDecimalExponent || shift < -maxDecimalExponent {
+			return nil, 0, false
+		}
+		exponent += shift
+	}
+
+	places := 0
+	if trimmed := strings.TrimRight(match[2] + match[3], "0"); trimmed != "" {
+		places = -exponent - (len(match[2]) + len(match[3]) - len(trimmed))
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exponent))), nil)
+	if exponent < 0 {
+		return new(big.Rat).SetFrac(digits, scale), places, true
+	}
+
+	return new(big.Rat).SetInt(digits.Mul(digits, scale)), places, true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// Converts a rule argument to a rational. Arguments which can't be parsed count as zero.
+func (v DecimalValidityChecker) toRat(s string) *big.Rat {
+	out, ok := decimalToRat(s)
+	if !ok {
+		return new(big.Rat)
+	}
+
+	return out
+}
+
+// Gets the number of digits before the decimal point from the item, exactly. Zero has one digit, so 0.5 has one too,
+// and the sign isn't counted.
+func (v DecimalValidityChecker) getDigits() int {
+	whole := new(big.Int).Quo(v.Item.Num(), v.Item.Denom())
+
+	return len(whole.Abs(whole).Text(10))
+}
+
+// Converts a string to an integer. That's all there is!
+func (v DecimalValidityChecker) toInt(s string) int {
+	out, _ := strconv.Atoi(s)
+
+	return out
+}
+
+func (v DecimalValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v DecimalValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v DecimalValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v DecimalValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v DecimalValidityChecker) ValidateAccepted() bool {
+	return v.Item.Sign() > 0
+}
+
+func (v DecimalValidityChecker) ValidateBetween(min string, max string) bool {
+	return v.Item.Cmp(v.toRat(min)) >= 0 && v.Item.Cmp(v.toRat(max)) <= 0
+}
+
+// Passes if the value needs no more than the given number of decimal places, so "19.90" passes "decimal_places:1".
+func (v DecimalValidityChecker) ValidateDecimalPlaces(places string) bool {
+	return v.Places <= v.toInt(places)
+}
+
+func (v DecimalValidityChecker) ValidateDigits(num string) bool {
+	return v.getDigits() == v.toInt(num)
+}
+
+func (v DecimalValidityChecker) ValidateDigitsBetween(min string, max string) bool {
+	digits := v.getDigits()
+
+	return digits >= v.toInt(min) && digits <= v.toInt(max)
+}
+
+func (v DecimalValidityChecker) ValidateMax(max string) bool {
+	return v.Item.Cmp(v.toRat(max)) <= 0
+}
+
+// Passes if the value has no more than the given number of digits, before and after the decimal point together, like
+// the precision of an SQL DECIMAL column. Leading and trailing zeros don't count, except for a lone zero.
+func (v DecimalValidityChecker) ValidateMaxDigits(max string) bool {
+	whole  := new(big.Int).Quo(v.Item.Num(), v.Item.Denom())
+	digits := v.Places
+	if whole.Sign() != 0 {
+		digits += len(whole.Abs(whole).Text(10))
+	}
+	if digits == 0 {
+		digits = 1
+	}
+
+	return digits <= v.toInt(max)
+}
+
+func (v DecimalValidityChecker) ValidateMin(min string) bool {
+	return v.Item.Cmp(v.toRat(min)) >= 0
+}
+
+// Values are compared exactly, so "1.50" is in "in:1.5".
+func (v DecimalValidityChecker) ValidateIn(values ...string) bool {
+	for _, value := range values {
+		if out, ok := decimalToRat(value); ok && v.Item.Cmp(out) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v DecimalValidityChecker) ValidateNotIn(values ...string) bool {
+	return !v.ValidateIn(values...)
+}
+
+func (v DecimalValidityChecker) ValidateEnum(name string) bool {
+	values, exists := enumValues(name)
+
+	return exists && v.ValidateIn(values...)
+}
@@ -0,0 +1,361 @@
+package validity
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	pass := map[interface{}]string{
+		"19.99":                      "1999/100",
+		"-0.50":                      "-1/2",
+		"1.5e3":                      "1500/1",
+		"2E-2":                       "1/50",
+		0.1:                          "1/10",
+		float32(0.1):                 "1/10",
+		42:                           "42/1",
+		uint64(18446744073709551615): "18446744073709551615/1",
+		json.Number("0.30"):          "3/10",
+		"123456789012345678901.0001": "1234567890123456789010001/10000",
+	}
+
+	for input, expected := range pass {
+		actual, ok := parseDecimal(input)
+		if !ok || actual.String() != expected {
+			t.Errorf("%#v should parse to %s, got %v.", input, expected, actual)
+		}
+	}
+
+	long := strings.Repeat("1", maxDecimalDigits + 1)
+	for _, input := range []interface{}{"", "abc", "1,5", "0x10", "1/3", "1e99999", long, "0." + long, true, nil} {
+		if _, ok := parseDecimal(input); ok {
+			t.Errorf("%#v should not parse as a decimal.", input)
+		}
+	}
+}
+
+func TestParseDecimalPlaces(t *testing.T) {
+	tests := map[interface{}]int{
+		"19.99":  2,
+		"19.90":  1,
+		"-0.50":  1,
+		"1.5e3":  0,
+		"1.25e1": 1,
+		"2E-2":   2,
+		"100":    0,
+		"0.000":  0,
+		0.001:    3,
+		42:       0,
+	}
+
+	for input, expected := range tests {
+		if _, places, ok := parseDecimalPlaces(input); !ok || places != expected {
+			t.Errorf("%#v should need %d decimal places, got %d.", input, expected, places)
+		}
+	}
+}
+
+func TestDecimalData(t *testing.T) {
+	results := ValidateMap(map[string]interface{}{"foo": "0.10"}, ValidationRules{"foo": []string{"Decimal"}})
+
+	sum := new(big.Rat).Add(results.Data["foo"].(*big.Rat), big.NewRat(2, 10))
+	if sum.FloatString(2) != "0.30" {
+		t.Errorf("Decimal Data should be exact, got %s.", sum.FloatString(20))
+	}
+}
+
+
+
+func TestDecimalValidateAcceptedPass(t *testing.T) {
+	data := TestStruct{Foo: "0.01"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "accepted"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal accepted validator does not pass.")
+	}
+}
+func TestDecimalValidateAcceptedFail(t *testing.T) {
+	data := TestStruct{Foo: "0"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "accepted"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal accepted validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateDigitsPass(t *testing.T) {
+	data := TestStruct{Foo: "-123.99"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "digits:3"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal digits validator does not pass.")
+	}
+}
+func TestDecimalValidateDigitsFail(t *testing.T) {
+	data := TestStruct{Foo: "1234.5"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "digits:3"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal digits validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateDigitsBetweenPass(t *testing.T) {
+	data := TestStruct{Foo: "0.5"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "digits_between:1,2"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal digits between validator does not pass.")
+	}
+}
+func TestDecimalValidateDigitsBetweenFail(t *testing.T) {
+	data := TestStruct{Foo: "100.25"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "digits_between:1,2"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal digits between validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateInPass(t *testing.T) {
+	data := TestStruct{Foo: "1.50"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "in:1.5,2"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal in validator does not pass.")
+	}
+}
+func TestDecimalValidateInFail(t *testing.T) {
+	data := TestStruct{Foo: "1.505"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "in:1.5,2"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal in validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateNotInPass(t *testing.T) {
+	data := TestStruct{Foo: "1.505"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "not_in:1.5,2"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal not in validator does not pass.")
+	}
+}
+func TestDecimalValidateNotInFail(t *testing.T) {
+	data := TestStruct{Foo: "2.00"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "not_in:1.5,2"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal not in validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateEnumPass(t *testing.T) {
+	RegisterEnum("test_decimal_price", "4.99", "9.99")
+	data := TestStruct{Foo: "9.990"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "enum:test_decimal_price"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Decimal enum validator does not pass.")
+	}
+}
+func TestDecimalValidateEnumFail(t *testing.T) {
+	RegisterEnum("test_decimal_price", "4.99", "9.99")
+	data := TestStruct{Foo: "10"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "enum:test_decimal_price"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal enum validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateBetweenPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "between:0.01,19.99"}}
+
+	for _, value := range []interface{}{"0.01", "19.99", 10} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal between validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateBetweenFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "between:0.01,19.99"}}
+
+	for _, value := range []interface{}{"0.009", "19.991", -1} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal between validator does not fail %v.", value)
+		}
+	}
+}
+
+
+
+func TestDecimalValidateDecimalPlacesPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "decimal_places:2"}}
+
+	for _, value := range []interface{}{"1", "1.5", "1.25", "19.990", 0.1} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal decimal_places validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateDecimalPlacesFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "decimal_places:2"}}
+
+	for _, value := range []interface{}{"1.255", 0.001} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal decimal_places validator does not fail %v.", value)
+		}
+	}
+}
+func TestDecimalValidateDecimalPlacesZeroPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"Decimal", "decimal_places:0"}}
+
+	for _, value := range []string{"10", "1e3", "2.0"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal decimal_places validator does not pass %q.", value)
+		}
+	}
+}
+func TestDecimalValidateDecimalPlacesZeroFail(t *testing.T) {
+	data := TestStruct{Foo: "0.5"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "decimal_places:0"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal decimal_places validator does not fail.")
+	}
+}
+
+
+
+func TestDecimalValidateMaxPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "max:19.99"}}
+
+	for _, value := range []interface{}{"19.99", "-100", 19} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal max validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateMaxFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "max:19.99"}}
+
+	for _, value := range []interface{}{"19.990000001", 20} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal max validator does not fail %v.", value)
+		}
+	}
+}
+
+
+
+func TestDecimalValidateMaxDigitsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"Decimal", "max_digits:5"}}
+
+	for _, value := range []string{"123.45", "-123.45", "0.0001", "0", "12345", "00012.3400"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal max_digits validator does not pass %q.", value)
+		}
+	}
+}
+func TestDecimalValidateMaxDigitsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"Decimal", "max_digits:5"}}
+
+	for _, value := range []string{"1234.56", "0.000001", "123456"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal max_digits validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestDecimalValidateMinPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "min:0.1"}}
+
+	for _, value := range []interface{}{"0.1", 0.1, "1"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal min validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateMinFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "min:0.1"}}
+
+	for _, value := range []interface{}{"0.0999", "-1"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal min validator does not fail %v.", value)
+		}
+	}
+}
+
+
+
+func TestDecimalValidateMultipleOfPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "multiple_of:0.05"}}
+
+	for _, value := range []interface{}{"0.05", "1.10", "0", "-0.15", 0.3} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal multiple_of validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateMultipleOfFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "multiple_of:0.05"}}
+
+	for _, value := range []interface{}{"0.01", "1.12"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal multiple_of validator does not fail %v.", value)
+		}
+	}
+}
+func TestDecimalValidateMultipleOfZeroFail(t *testing.T) {
+	data := TestStruct{Foo: "1"}
+	rules := ValidationRules{"Foo": []string{"Decimal", "multiple_of:0"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Decimal multiple_of validator does not fail.")
+	}
+}
@@ -0,0 +1,116 @@
+package validity
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Checks durations, for the Duration type. Values are read by time.ParseDuration, which also accepts "d" for days
+// and "w" for weeks, like "1w2d" or "1.5d". The item is a time.Duration, which is what ends up in the results Data.
+// Rule arguments are durations too, like "max:1d".
+type DurationValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  time.Duration
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// One part of a duration, like "1.5d".
+var durationPart = regexp.MustCompile(`(\d*\.?\d*)([a-z\x{b5}\x{3bc}]+)`)
+
+// Hours in each of the units which time.ParseDuration doesn't know.
+var durationDays = map[string]int64{"d": 24, "w": 7 * 24}
+
+// Numbers of days and weeks longer than this are rejected. A time.Duration only holds about 20 digits anyway.
+const maxDurationNumber = 32
+
+// Parses the duration, rewriting days and weeks as hours for time.ParseDuration.
+func parseDuration(s string) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+
+	rewritten := durationPart.ReplaceAllStringFunc(s, func(part string) string {
+		match := durationPart.FindStringSubmatch(part)
+		hours, ok := durationDays[match[2]]
+		if !ok {
+			return part
+		}
+
+		if len(match[1]) > maxDurationNumber {
+			return part
+		}
+
+		// Multiplying by a whole number of hours never needs more decimal places than the number had.
+		value, places, ok := decimalToRatPlaces(match[1])
+		if !ok {
+			return part
+		}
+
+		value.Mul(value, new(big.Rat).SetInt64(hours))
+
+		return value.FloatString(places) + "h"
+	})
+
+	out, err := time.ParseDuration(rewritten)
+
+	return out, err == nil
+}
+
+// Converts the value to a duration. Strings are parsed, and time.Duration values are used as they are. Other numbers
+// aren't accepted, as it's anyone's guess what unit they're in.
+func toDuration(value interface{}) (time.Duration, bool) {
+	if duration, ok := value.(time.Duration); ok {
+		return duration, true
+	}
+
+	if s, ok := value.(string); ok {
+		return parseDuration(s)
+	}
+
+	return parseDuration(fmt.Sprintf("%v", value))
+}
+
+// Converts a rule argument to a duration. Arguments which can't be parsed aren't ok, and fail the rule.
+func (v DurationValidityChecker) toDuration(s string) (time.Duration, bool) {
+	return parseDuration(s)
+}
+
+func (v DurationValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v DurationValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v DurationValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v DurationValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v DurationValidityChecker) ValidateBetween(min string, max string) bool {
+	return v.ValidateMin(min) && v.ValidateMax(max)
+}
+
+func (v DurationValidityChecker) ValidateMax(max string) bool {
+	duration, ok := v.toDuration(max)
+
+	return ok && v.Item <= duration
+}
+
+func (v DurationValidityChecker) ValidateMin(min string) bool {
+	duration, ok := v.toDuration(min)
+
+	return ok && v.Item >= duration
+}
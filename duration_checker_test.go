@@ -0,0 +1,82 @@
+package validity
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	pass := map[string]time.Duration{
+		"30s":       30 * time.Second,
+		"1h30m":     90 * time.Minute,
+		"1d":        24 * time.Hour,
+		"1.5d":      36 * time.Hour,
+		"2w":        14 * 24 * time.Hour,
+		"1w2d3h":    (9*24 + 3) * time.Hour,
+		"-1d":       -24 * time.Hour,
+		"250ms":     250 * time.Millisecond,
+		"5\u00b5s":   5 * time.Microsecond,
+		" 10m ":     10 * time.Minute,
+		"0":         0,
+		"0.5w":      84 * time.Hour,
+		"1.125d":    27 * time.Hour,
+	}
+
+	for input, expected := range pass {
+		if actual, ok := parseDuration(input); !ok || actual != expected {
+			t.Errorf("%q should parse to %v, got %v.", input, expected, actual)
+		}
+	}
+
+	long := "0." + strings.Repeat("1", 50000) + "d"
+	for _, input := range []string{"", "30", "1y", "d", "1dd", "1.d5", "abc", "1 d", long} {
+		if actual, ok := parseDuration(input); ok {
+			t.Errorf("%q should not parse as a duration, got %v.", input, actual)
+		}
+	}
+}
+
+
+
+func TestDurationType(t *testing.T) {
+	data := map[string]interface{}{"timeout": "30s", "ttl": 2 * time.Hour, "bad": 30, "week": "1w"}
+	rules := ValidationRules{
+		"timeout": []string{"Duration", "min:1s", "max:1m"},
+		"ttl":     []string{"Duration", "between:1h,1d"},
+		"bad":     []string{"Duration"},
+		"week":    []string{"Duration", "max:6d"},
+	}
+
+	results := ValidateMap(data, rules)
+	if results.Data["timeout"] != 30*time.Second || results.Data["ttl"] != 2*time.Hour ||
+		len(results.Errors["bad"]) != 1 || results.Errors["bad"][0] != "Duration" ||
+		len(results.Errors["week"]) != 1 || results.Errors["week"][0] != "Max" {
+		t.Errorf("Duration type does not validate. Results: %v", results)
+	}
+}
+
+
+
+type TestStructDuration struct {
+	Timeout time.Duration `validators:"min:1s"`
+}
+
+func TestDurationStructTags(t *testing.T) {
+	results := ValidateStructTags(TestStructDuration{Timeout: 5 * time.Second})
+	if !results.IsValid || results.Data["Timeout"] != 5*time.Second {
+		t.Errorf("Duration struct fields should be inferred. Results: %v", results)
+	}
+}
+
+
+
+func TestDurationValidateBadArgument(t *testing.T) {
+	data := map[string]interface{}{"timeout": "30s"}
+	rules := ValidationRules{"timeout": []string{"Duration", "max:1mm"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Duration validator does not fail on an argument that isn't a duration.")
+	}
+}
@@ -0,0 +1,246 @@
+package validity
+
+import (
+	"bufio"
+	"net"
+	"net/mail"
+	"os"
+	"strings"
+	"sync"
+)
+
+type EmailValidityChecker struct {
+	Key   string
+	Rules []string
+	// Item is the bare address, without any display name, with its domain lowercased. Local parts which need quoting
+	// are quoted, so it is always a valid address to send to.
+	Item  string
+	// Name is the display name, if the input was in the form "Name <user@example.com>".
+	Name  string
+	// The local part (before the @) and the domain, split out for easier checking. Quotes are not included in Local.
+	Local  string
+	Domain string
+}
+
+// Domains from LoadDisposableDomains, for the "not_disposable" rule.
+var (
+	disposableDomains     = map[string]bool{}
+	disposableDomainsLock sync.RWMutex
+)
+
+// Parses the email address using net/mail, which follows RFC 5322. On top of that the domain must be either an IP
+// literal, like "[127.0.0.1]", or a hostname with at least two labels and a top level domain which isn't all digits,
+// as otherwise "user@localhost", "user@1.2.3.4" or typos like "user@gmail" would pass. Internationalised domains, like
+// "bücher.de", are checked in their ASCII form.
+func parseEmail(s string) (*mail.Address, string, string, bool) {
+	address, err := mail.ParseAddress(s)
+	if err != nil {
+		return nil, "", "", false
+	}
+
+	at     := strings.LastIndex(address.Address, "@")
+	local  := address.Address[:at]
+	domain := strings.ToLower(address.Address[at+1:])
+
+	if isEmailIPLiteral(domain) {
+		return address, local, domain, true
+	}
+
+	ascii, ok := domainToASCII(domain)
+	labels   := strings.Split(domain, ".")
+	tld      := labels[len(labels)-1]
+	if !ok || len(labels) < 2 || strings.Trim(tld, "0123456789") == "" || !isHostname(ascii) {
+		return nil, "", "", false
+	}
+
+	return address, local, domain, true
+}
+
+// Returns whether the domain is an address literal in square brackets, like [127.0.0.1] or [IPv6:::1].
+func isEmailIPLiteral(domain string) bool {
+	if !strings.HasPrefix(domain, "[") || !strings.HasSuffix(domain, "]") {
+		return false
+	}
+
+	inner := domain[1:len(domain)-1]
+	if strings.HasPrefix(inner, "ipv6:") {
+		ip := net.ParseIP(inner[5:])
+		return ip != nil && ip.To4() == nil
+	}
+
+	ip := net.ParseIP(inner)
+	return ip != nil && ip.To4() != nil
+}
+
+// Returns whether the string is an RFC 1123 hostname: dot seperated labels of letters, digits and hyphens, which do
+// not start or end with a hyphen and are no more than 63 characters, totalling no more than 253 characters.
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(s, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Returns whether the local part is a dot-atom, that is, it can be written without quotes.
+func isDotAtom(local string) bool {
+	if local == "" || local[0] == '.' || local[len(local)-1] == '.' || strings.Contains(local, "..") {
+		return false
+	}
+
+	for _, c := range local {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80 ||
+			strings.ContainsRune(".!#$%&'*+-/=?^_`{|}~", c)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Formats the address as an addr-spec, quoting the local part if it needs it.
+func formatAddrSpec(local string, domain string) string {
+	if !isDotAtom(local) {
+		local = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(local) + `"`
+	}
+
+	return local + "@" + domain
+}
+
+// Loads a list of disposable email domains from a file, one per line, to be used by the "not_disposable" rule. Blank
+// lines and lines starting with # are ignored. The list replaces any loaded before.
+func LoadDisposableDomains(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	domains := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.ToLower(strings.TrimSpace(scanner.Text()))
+		if line != "" && !strings.HasPrefix(line, "#") {
+			domains[line] = true
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	disposableDomainsLock.Lock()
+	disposableDomains = domains
+	disposableDomainsLock.Unlock()
+
+	return nil
+}
+
+// Returns whether the domain, or any domain it is under, is in the given list.
+func domainInList(domain string, list []string) bool {
+	for _, item := range list {
+		item = strings.ToLower(item)
+
+		if domain == item || strings.HasSuffix(domain, "." + item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v EmailValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v EmailValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v EmailValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v EmailValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v EmailValidityChecker) ValidateNoDisplayName() bool {
+	return v.Name == ""
+}
+
+func (v EmailValidityChecker) ValidateNoQuotedLocal() bool {
+	return isDotAtom(v.Local)
+}
+
+func (v EmailValidityChecker) ValidateNoIpLiteral() bool {
+	return !strings.HasPrefix(v.Domain, "[")
+}
+
+func (v EmailValidityChecker) ValidateDomainIn(domains ...string) bool {
+	return domainInList(v.Domain, domains)
+}
+
+func (v EmailValidityChecker) ValidateDomainNotIn(domains ...string) bool {
+	return !domainInList(v.Domain, domains)
+}
+
+func (v EmailValidityChecker) ValidateNotDisposable() bool {
+	disposableDomainsLock.RLock()
+	defer disposableDomainsLock.RUnlock()
+
+	for domain := v.Domain; domain != ""; {
+		if disposableDomains[domain] {
+			return false
+		}
+
+		dot := strings.Index(domain, ".")
+		if dot < 0 {
+			break
+		}
+		domain = domain[dot+1:]
+	}
+
+	return true
+}
+
+// Passes if the domain has at least one mail exchanger, looked up through EmailResolver. A "null MX" record, which is
+// a single exchanger of ".", means the domain does not accept mail and so fails.
+func (v EmailValidityChecker) ValidateMx() bool {
+	if strings.HasPrefix(v.Domain, "[") {
+		return false
+	}
+
+	domain, ok := domainToASCII(v.Domain)
+	if !ok {
+		return false
+	}
+
+	ctx, cancel := lookupContext()
+	defer cancel()
+
+	records, err := EmailResolver.LookupMX(ctx, domain)
+	if err != nil || len(records) == 0 {
+		return false
+	}
+
+	return !(len(records) == 1 && (records[0].Host == "." || records[0].Host == ""))
+}
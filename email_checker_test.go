@@ -0,0 +1,185 @@
+package validity
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type fakeMXResolver map[string][]*net.MX
+
+func (f fakeMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if records, exists := f[name]; exists {
+		return records, nil
+	}
+
+	return nil, errors.New("no such host")
+}
+
+// Never answers, so lookups only end when their context does.
+type slowMXResolver struct{}
+
+func (slowMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	<-ctx.Done()
+
+	return nil, ctx.Err()
+}
+
+func TestEmailParsesAddress(t *testing.T) {
+	data := map[string]interface{}{"a": "Connor <connor@Peet.IO>", "b": "\"john doe\"@example.com"}
+	rules := ValidationRules{"a": []string{"Email"}, "b": []string{"Email"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["a"] != "connor@peet.io" || results.Data["b"] != "\"john doe\"@example.com" {
+		t.Errorf("Email type does not parse addresses. Results: %v", results)
+	}
+}
+func TestEmailRejectsInvalid(t *testing.T) {
+	for _, address := range []string{"a b@c.d", "connor@invalid", "bob@x.com\n", "a\n@b.c", "bob@-x.com", "nope",
+		"a@1.2.3.4", "a@example.123"} {
+		results := ValidateMap(map[string]interface{}{"a": address}, ValidationRules{"a": []string{"Email"}})
+		if results.IsValid || results.Errors["a"][0] != "Email" {
+			t.Errorf("Email type does not reject %q.", address)
+		}
+	}
+}
+
+
+func TestEmailAllowsInternationalDomains(t *testing.T) {
+	data := map[string]interface{}{"a": "user@bücher.de", "b": "user@München.example"}
+	rules := ValidationRules{"a": []string{"Email"}, "b": []string{"Email"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["a"] != "user@bücher.de" || results.Data["b"] != "user@münchen.example" {
+		t.Errorf("Email type does not allow internationalised domains. Results: %v", results)
+	}
+}
+
+
+
+func TestEmailValidateNoDisplayName(t *testing.T) {
+	data := map[string]interface{}{"a": "connor@peet.io", "b": "Connor <connor@peet.io>"}
+	rules := ValidationRules{"a": []string{"Email", "no_display_name"}, "b": []string{"Email", "no_display_name"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Email no_display_name validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestEmailValidateNoQuotedLocal(t *testing.T) {
+	data := map[string]interface{}{"a": "john.doe@example.com", "b": "\"john doe\"@example.com"}
+	rules := ValidationRules{"a": []string{"Email", "no_quoted_local"}, "b": []string{"Email", "no_quoted_local"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Email no_quoted_local validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestEmailValidateNoIpLiteral(t *testing.T) {
+	data := map[string]interface{}{"a": "john@example.com", "b": "john@[127.0.0.1]"}
+	rules := ValidationRules{"a": []string{"Email", "no_ip_literal"}, "b": []string{"Email", "no_ip_literal"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Email no_ip_literal validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestEmailValidateDomainIn(t *testing.T) {
+	data := map[string]interface{}{"a": "john@mail.example.com", "b": "john@example.org"}
+	rules := ValidationRules{"a": []string{"Email", "domain_in:example.com,example.net"}, "b": []string{"Email", "domain_in:example.com,example.net"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Email domain_in validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestEmailValidateDomainNotIn(t *testing.T) {
+	data := map[string]interface{}{"a": "john@example.org", "b": "john@EXAMPLE.com"}
+	rules := ValidationRules{"a": []string{"Email", "domain_not_in:example.com"}, "b": []string{"Email", "domain_not_in:example.com"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Email domain_not_in validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestEmailValidateNotDisposable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disposable.txt")
+	os.WriteFile(path, []byte("# Disposable domains\nmailinator.com\n\nTrashMail.net\n"), 0644)
+
+	if err := LoadDisposableDomains(path); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { disposableDomains = map[string]bool{} }()
+
+	data := map[string]interface{}{"a": "john@example.com", "b": "john@mailinator.com", "c": "john@x.trashmail.net"}
+	rules := ValidationRules{
+		"a": []string{"Email", "not_disposable"},
+		"b": []string{"Email", "not_disposable"},
+		"c": []string{"Email", "not_disposable"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 {
+		t.Errorf("Email not_disposable validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestEmailLoadDisposableDomainsMissingFile(t *testing.T) {
+	if err := LoadDisposableDomains("/does/not/exist"); err == nil {
+		t.Errorf("Loading a missing disposable domains file should fail.")
+	}
+}
+
+
+
+func TestEmailValidateMx(t *testing.T) {
+	original := EmailResolver
+	defer func() { EmailResolver = original }()
+	EmailResolver = fakeMXResolver{
+		"example.com": []*net.MX{{Host: "mx.example.com.", Pref: 10}},
+		"nomail.com":  []*net.MX{{Host: ".", Pref: 0}},
+	}
+
+	data := map[string]interface{}{"a": "john@example.com", "b": "john@nomail.com", "c": "john@missing.com"}
+	rules := ValidationRules{"a": []string{"Email", "mx"}, "b": []string{"Email", "mx"}, "c": []string{"Email", "mx"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 {
+		t.Errorf("Email mx validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestEmailValidateMxInternational(t *testing.T) {
+	original := EmailResolver
+	defer func() { EmailResolver = original }()
+	EmailResolver = fakeMXResolver{"xn--bcher-kva.de": []*net.MX{{Host: "mx.example.com.", Pref: 10}}}
+
+	results := ValidateMap(map[string]interface{}{"a": "user@bücher.de"}, ValidationRules{"a": []string{"Email", "mx"}})
+	if !results.IsValid {
+		t.Errorf("Email mx validator does not look up the ASCII form of the domain. Errors: %v", results.Errors)
+	}
+}
+func TestEmailValidateMxTimeout(t *testing.T) {
+	original, originalTimeout := EmailResolver, LookupTimeout
+	defer func() { EmailResolver, LookupTimeout = original, originalTimeout }()
+	EmailResolver, LookupTimeout = slowMXResolver{}, 10*time.Millisecond
+
+	results := ValidateMap(map[string]interface{}{"a": "john@example.com"}, ValidationRules{"a": []string{"Email", "mx"}})
+	if results.IsValid {
+		t.Errorf("Email mx validator does not fail when the lookup times out.")
+	}
+}
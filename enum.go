@@ -0,0 +1,91 @@
+package validity
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// Registered enums, which are used by the "enum:name" rule. Values are kept as strings, and each checker converts them
+// to its own type when comparing.
+var (
+	enums     = map[string][]string{}
+	enumsLock sync.RWMutex
+)
+
+// Registers a list of allowed values under the given name, so that the rule "enum:name" only allows those values.
+// Registering a name again replaces its values. For example:
+//
+//		RegisterEnum("status", "draft", "published", "archived")
+//		rules := ValidationRules{"status": []string{"String", "enum:status"}}
+//
+func RegisterEnum(name string, values ...interface{}) {
+	list := make([]string, len(values))
+	for i, value := range values {
+		list[i] = enumString(reflect.ValueOf(value))
+	}
+
+	enumsLock.Lock()
+	enums[name] = list
+	enumsLock.Unlock()
+}
+
+// Registers a Go enum type under the given name. The type must have a method Values() which takes no arguments and
+// returns a slice of every allowed value, like:
+//
+//		type Status int
+//		func (Status) Values() []Status { return []Status{Draft, Published, Archived} }
+//
+//		RegisterEnumType("status", Status(0))
+//
+// Values are compared by their underlying kind, so the Status above is checked against integers even if it has a
+// String() method. Returns an error if the type has no suitable Values() method.
+func RegisterEnumType(name string, enum interface{}) error {
+	method := reflect.ValueOf(enum).MethodByName("Values")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return errors.New("validity: enum type must have a method Values() returning a slice")
+	}
+
+	values := method.Call(nil)[0]
+	if values.Kind() != reflect.Slice && values.Kind() != reflect.Array {
+		return errors.New("validity: enum type Values() must return a slice")
+	}
+
+	list := make([]string, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		list[i] = enumString(values.Index(i))
+	}
+
+	enumsLock.Lock()
+	enums[name] = list
+	enumsLock.Unlock()
+
+	return nil
+}
+
+// Returns the values registered under the name, and whether it exists.
+func enumValues(name string) ([]string, bool) {
+	enumsLock.RLock()
+	defer enumsLock.RUnlock()
+
+	values, exists := enums[name]
+	return values, exists
+}
+
+// Converts an enum value to a string by its underlying kind, ignoring any String() method it might have.
+func enumString(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	case reflect.String:
+		return value.String()
+	default:
+		return fmt.Sprintf("%v", value.Interface())
+	}
+}
@@ -0,0 +1,55 @@
+package validity
+
+import (
+	"testing"
+)
+
+type testEnumStatus int
+
+func (s testEnumStatus) Values() []testEnumStatus {
+	return []testEnumStatus{1, 2, 3}
+}
+
+func (s testEnumStatus) String() string {
+	return "status"
+}
+
+func TestEnumValues(t *testing.T) {
+	RegisterEnum("test_colour", "red", "green", "blue")
+	rules := ValidationRules{"Foo": []string{"String", "enum:test_colour"}}
+
+	if !ValidateStruct(TestStruct{Foo: "green"}, rules).IsValid {
+		t.Errorf("Enum validator does not pass registered values.")
+	}
+	if ValidateStruct(TestStruct{Foo: "purple"}, rules).IsValid {
+		t.Errorf("Enum validator does not fail unregistered values.")
+	}
+}
+
+func TestEnumType(t *testing.T) {
+	if err := RegisterEnumType("test_status", testEnumStatus(0)); err != nil {
+		t.Fatalf("Could not register enum type: %s", err)
+	}
+	rules := ValidationRules{"Bar": []string{"Int", "enum:test_status"}}
+
+	if !ValidateStruct(TestStruct{Bar: 2}, rules).IsValid {
+		t.Errorf("Enum type validator does not pass its values.")
+	}
+	if ValidateStruct(TestStruct{Bar: 4}, rules).IsValid {
+		t.Errorf("Enum type validator does not fail other values.")
+	}
+}
+
+func TestEnumTypeWithoutValues(t *testing.T) {
+	if err := RegisterEnumType("test_bad", 42); err == nil {
+		t.Errorf("Registering a type without Values() should fail.")
+	}
+}
+
+func TestEnumUnknownName(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "enum:test_does_not_exist"}}
+
+	if ValidateStruct(TestStruct{Foo: "anything"}, rules).IsValid {
+		t.Errorf("Enum validator should fail for unregistered enums.")
+	}
+}
@@ -0,0 +1,186 @@
+package validity
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ValidityFilters holds the filters which may be used in rules. Filters run before the value is parsed into its type,
+// in the order they are given, and each one receives the output of the one before. Like validators, they're called
+// by name: the rule "collapse_whitespace" calls FilterCollapseWhitespace. A filter takes the value as a string, plus
+// any arguments from the rule, and returns the new value. Your own filters are added with RegisterFilter.
+type ValidityFilters struct{}
+
+// Filters added with RegisterFilter, by their StudlyCased name.
+var (
+	customFilters     = map[string]func(string) string{}
+	customFiltersLock sync.RWMutex
+)
+
+// Registers a filter under the given name, so that the rule "name" runs it over the value. Registering a name again
+// replaces its filter, and a registered filter takes the place of a built-in one with the same name. For example:
+//
+//		RegisterFilter("reverse", func(s string) string { ... })
+//		rules := ValidationRules{"name": []string{"String", "reverse"}}
+//
+func RegisterFilter(name string, fn func(string) string) {
+	customFiltersLock.Lock()
+	customFilters[snakeToStudly(name)] = fn
+	customFiltersLock.Unlock()
+}
+
+// Gets the filter registered under the StudlyCased name, if there is one.
+func customFilter(method string) (func(string) string, bool) {
+	customFiltersLock.RLock()
+	defer customFiltersLock.RUnlock()
+
+	fn, exists := customFilters[method]
+	return fn, exists
+}
+
+var htmlTagExpression = regexp.MustCompile(`(?s)<!--.*?-->|<[^>]*>`)
+
+// Validators which imply filters, by their StudlyCased name. The filters run where the validator appears in the rules,
+// so that identifiers like card numbers can be written with spaces but are validated and returned without them.
+var impliedFilters = map[string][]string{
+	"CreditCard": {"strip_separators"},
+	"Iban":       {"strip_separators", "upper"},
+	"Bic":        {"strip_separators", "upper"},
+	"Isbn10":     {"strip_separators", "upper"},
+	"Isbn13":     {"strip_separators"},
+	"Ean8":       {"strip_separators"},
+	"Ean13":      {"strip_separators"},
+	"Upc":        {"strip_separators"},
+	"Isin":       {"strip_separators", "upper"},
+}
+
+// Returns whether the StudlyCased rule name is a filter.
+func isFilter(method string) bool {
+	if _, exists := customFilter(method); exists {
+		return true
+	}
+
+	return reflect.ValueOf(ValidityFilters{}).MethodByName("Filter" + method).IsValid()
+}
+
+// Runs any filters in the rules over the item, in order. If there are no filters then the item is returned untouched,
+// otherwise it is converted to a string first and the filtered string is returned.
+func applyFilters(item interface{}, rules []string) interface{} {
+	filtered := fmt.Sprintf("%v", item)
+	found    := false
+
+	for _, rule := range rules {
+		method, args := parseRule(rule)
+
+		if implied, exists := impliedFilters[method]; exists {
+			for _, filter := range implied {
+				filtered = runFilter(filtered, snakeToStudly(filter), nil)
+			}
+			found = true
+			continue
+		}
+
+		if isFilter(method) {
+			filtered = runFilter(filtered, method, args)
+			found = true
+		}
+	}
+
+	if !found {
+		return item
+	}
+
+	return filtered
+}
+
+// Calls a single filter with the given arguments. Registered filters take no arguments, so any are ignored.
+func runFilter(item string, method string, args []string) string {
+	if fn, exists := customFilter(method); exists {
+		return fn(item)
+	}
+
+	params := []interface{}{item}
+	for _, arg := range args {
+		params = append(params, arg)
+	}
+
+	result, _ := callIn(ValidityFilters{}, "Filter" + method, params...)
+	return result[0].String()
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving filter rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (f ValidityFilters) FilterTrim(s string) string {
+	return strings.TrimSpace(s)
+}
+
+func (f ValidityFilters) FilterLtrim(s string) string {
+	return strings.TrimLeftFunc(s, unicode.IsSpace)
+}
+
+func (f ValidityFilters) FilterRtrim(s string) string {
+	return strings.TrimRightFunc(s, unicode.IsSpace)
+}
+
+func (f ValidityFilters) FilterLower(s string) string {
+	return strings.ToLower(s)
+}
+
+func (f ValidityFilters) FilterUpper(s string) string {
+	return strings.ToUpper(s)
+}
+
+// Uppercases the first letter of each word and lowercases the rest, so "hELLO world" becomes "Hello World".
+func (f ValidityFilters) FilterTitle(s string) string {
+	out       := []rune(strings.ToLower(s))
+	wordStart := true
+
+	for i, r := range out {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'' {
+			if wordStart {
+				out[i] = unicode.ToTitle(r)
+			}
+			wordStart = false
+		} else {
+			wordStart = true
+		}
+	}
+
+	return string(out)
+}
+
+// Replaces every run of whitespace with a single space, and trims the ends.
+func (f ValidityFilters) FilterCollapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Removes HTML tags and comments. Entities are left escaped, so this is not a replacement for escaping output!
+func (f ValidityFilters) FilterStripHtml(s string) string {
+	return htmlTagExpression.ReplaceAllString(s, "")
+}
+
+// Removes everything except the digits 0-9.
+func (f ValidityFilters) FilterDigitsOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// Removes whitespace and hyphens, which are often used to group the digits of card numbers and other identifiers.
+func (f ValidityFilters) FilterStripSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' {
+			return -1
+		}
+		return r
+	}, s)
+}
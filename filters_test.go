@@ -0,0 +1,104 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestFilterTrim(t *testing.T) {
+	data := TestStruct{Foo: "  bob "}
+	rules := ValidationRules{"Foo": []string{"String", "trim", "alpha"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != "bob" {
+		t.Errorf("Trim filter does not trim. Results: %v", results)
+	}
+}
+func TestFilterLtrimRtrim(t *testing.T) {
+	data := map[string]interface{}{"a": "  bob ", "b": "  bob "}
+	rules := ValidationRules{"a": []string{"String", "ltrim"}, "b": []string{"String", "rtrim"}}
+
+	results := ValidateMap(data, rules)
+	if results.Data["a"] != "bob " || results.Data["b"] != "  bob" {
+		t.Errorf("Ltrim and rtrim filters do not trim one side. Data: %v", results.Data)
+	}
+}
+
+
+
+func TestFilterCase(t *testing.T) {
+	data := map[string]interface{}{"a": "HeLLo wORLD", "b": "HeLLo wORLD", "c": "hELLO wORLD"}
+	rules := ValidationRules{"a": []string{"String", "lower"}, "b": []string{"String", "upper"}, "c": []string{"String", "title"}}
+
+	results := ValidateMap(data, rules)
+	if results.Data["a"] != "hello world" || results.Data["b"] != "HELLO WORLD" || results.Data["c"] != "Hello World" {
+		t.Errorf("Case filters do not change case. Data: %v", results.Data)
+	}
+}
+
+
+
+func TestFilterCollapseWhitespace(t *testing.T) {
+	data := TestStruct{Foo: " foo \t\n  bar  baz "}
+	rules := ValidationRules{"Foo": []string{"String", "collapse_whitespace"}}
+
+	results := ValidateStruct(data, rules)
+	if results.Data["Foo"] != "foo bar baz" {
+		t.Errorf("Collapse whitespace filter does not collapse. Data: %v", results.Data)
+	}
+}
+
+
+
+func TestFilterStripHtml(t *testing.T) {
+	data := TestStruct{Foo: "<p>Hello <b>there</b><!-- <i>x</i> --></p>"}
+	rules := ValidationRules{"Foo": []string{"String", "strip_html"}}
+
+	results := ValidateStruct(data, rules)
+	if results.Data["Foo"] != "Hello there" {
+		t.Errorf("Strip html filter does not strip tags. Data: %v", results.Data)
+	}
+}
+
+
+
+func TestFilterDigitsOnlyBeforeParsing(t *testing.T) {
+	data := TestStruct{Foo: "(555) 123-4567"}
+	rules := ValidationRules{"Foo": []string{"Int", "digits_only", "digits:10"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != int64(5551234567) {
+		t.Errorf("Digits only filter does not run before parsing. Results: %v", results)
+	}
+}
+
+
+
+func TestFiltersRunInOrder(t *testing.T) {
+	data := TestStruct{Foo: "  <b>Hello</b>  "}
+	rules := ValidationRules{"Foo": []string{"String", "strip_html", "trim", "upper", "alpha"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != "HELLO" {
+		t.Errorf("Filters do not run in order. Results: %v", results)
+	}
+}
+
+
+
+func TestRegisterFilter(t *testing.T) {
+	RegisterFilter("test_reverse", func(s string) string {
+		runes := []rune(s)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return string(runes)
+	})
+
+	data := TestStruct{Foo: " olleh"}
+	rules := ValidationRules{"Foo": []string{"String", "test_reverse", "trim", "alpha"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid || results.Data["Foo"] != "hello" {
+		t.Errorf("Registered filter does not run. Results: %v", results)
+	}
+}
@@ -1,14 +1,17 @@
 package validity
 
 import (
-	"strconv"
 	"math"
+	"strconv"
+	"strings"
 )
 
 type FloatValidityChecker struct {
 	Key   string
 	Rules []string
 	Item  float64
+	// Input is the whole of the data under validation, for rules which depend on other fields.
+	Input map[string]interface{}
 }
 
 // Converts a string to an float. That's all there is!
@@ -25,9 +28,30 @@ func (v FloatValidityChecker) toInt(s string) int64 {
 	return out
 }
 
-// Gets the number of non-decimal digits from the item.
+// Gets the number of digits before the decimal point from the item. Zero has one digit, so 0.5 has one too, and the
+// sign isn't counted.
 func (v FloatValidityChecker) getDigits() int64 {
-	return int64(math.Ceil(math.Log10(v.Item)))
+	integer, _, _ := v.countDigits()
+
+	return integer
+}
+
+// Counts the digits before and after the decimal point, and the significant digits, in the shortest decimal form of
+// the item. For example 120.05 has 3, 2 and 5, and 0.001 has 1, 3 and 1. NaN and infinities have no digits at all.
+func (v FloatValidityChecker) countDigits() (integer int64, fraction int64, significant int64) {
+	if math.IsNaN(v.Item) || math.IsInf(v.Item, 0) {
+		return 0, 0, 0
+	}
+
+	item  := math.Abs(v.Item)
+	parts := strings.SplitN(strconv.FormatFloat(item, 'f', -1, 64), ".", 2)
+	if len(parts) == 2 {
+		fraction = int64(len(parts[1]))
+	}
+
+	mantissa := strings.SplitN(strconv.FormatFloat(item, 'e', -1, 64), "e", 2)[0]
+
+	return int64(len(parts[0])), fraction, int64(len(strings.Replace(mantissa, ".", "", 1)))
 }
 
 func (v FloatValidityChecker) GetKey() string {
@@ -55,7 +79,7 @@ func (v FloatValidityChecker) ValidateAccepted() bool {
 }
 
 func (v FloatValidityChecker) ValidateBetween(min string, max string) bool {
-	return v.Item > v.toFloat(min) && v.Item < v.toFloat(max)
+	return v.Item >= v.toFloat(min) && v.Item <= v.toFloat(max)
 }
 
 func (v FloatValidityChecker) ValidateDigits(num string) bool {
@@ -65,13 +89,54 @@ func (v FloatValidityChecker) ValidateDigits(num string) bool {
 func (v FloatValidityChecker) ValidateDigitsBetween(min string, max string) bool {
 	digits := v.getDigits()
 
-	return digits > v.toInt(min) && digits < v.toInt(max)
+	return digits >= v.toInt(min) && digits <= v.toInt(max)
+}
+
+// Passes if there are no more than the given number of digits after the decimal point, in the shortest decimal form
+// of the value. So 0.1 has one, even though it can't be stored exactly.
+func (v FloatValidityChecker) ValidateDigitsFractionMax(max string) bool {
+	_, fraction, _ := v.countDigits()
+
+	return !math.IsNaN(v.Item) && !math.IsInf(v.Item, 0) && fraction <= v.toInt(max)
+}
+
+// Passes if there are no more than the given number of digits before the decimal point.
+func (v FloatValidityChecker) ValidateDigitsIntegerMax(max string) bool {
+	return !math.IsNaN(v.Item) && !math.IsInf(v.Item, 0) && v.getDigits() <= v.toInt(max)
+}
+
+// Passes if there are no more than the given number of significant digits, ignoring leading and trailing zeros. So
+// 1200 and 0.0012 both have two.
+func (v FloatValidityChecker) ValidateDigitsSignificantMax(max string) bool {
+	_, _, significant := v.countDigits()
+
+	return !math.IsNaN(v.Item) && !math.IsInf(v.Item, 0) && significant <= v.toInt(max)
 }
 
 func (v FloatValidityChecker) ValidateMax(max string) bool {
-	return v.Item < v.toFloat(max)
+	return v.Item <= v.toFloat(max)
 }
 
 func (v FloatValidityChecker) ValidateMin(min string) bool {
-	return v.Item > v.toFloat(min)
+	return v.Item >= v.toFloat(min)
+}
+
+func (v FloatValidityChecker) ValidateIn(values ...string) bool {
+	for _, value := range values {
+		if out, err := strconv.ParseFloat(value, 64); err == nil && v.Item == out {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v FloatValidityChecker) ValidateNotIn(values ...string) bool {
+	return !v.ValidateIn(values...)
+}
+
+func (v FloatValidityChecker) ValidateEnum(name string) bool {
+	values, exists := enumValues(name)
+
+	return exists && v.ValidateIn(values...)
 }
@@ -106,6 +106,86 @@ func TestFloatValidateDigitsBetweenFailUpper(t *testing.T) {
 
 
 
+func TestFloatValidateDigitsExact(t *testing.T) {
+	tests := map[float64]string{0: "1", 0.5: "1", 100: "3", 100.25: "3", -999.9: "3", 1e20: "21"}
+
+	for value, digits := range tests {
+		results := ValidateMap(map[string]interface{}{"Baz": value}, ValidationRules{"Baz": []string{"Float", "digits:" + digits}})
+		if !results.IsValid {
+			t.Errorf("Float digits validator should count %v as %s digits.", value, digits)
+		}
+	}
+}
+func TestFloatValidateDigitsBetweenInclusive(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_between:2,4"}, "b": []string{"Float", "digits_between:2,4"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 10.5, "b": -9999.0}, rules)
+	if !results.IsValid {
+		t.Errorf("Float digits between validator should include its bounds. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestFloatValidateDigitsIntegerMaxPass(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_integer_max:3"}, "b": []string{"Float", "digits_integer_max:3"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 999.999, "b": -0.5}, rules)
+	if !results.IsValid {
+		t.Errorf("Float digits integer max validator does not pass. Errors: %v", results.Errors)
+	}
+}
+func TestFloatValidateDigitsIntegerMaxFail(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_integer_max:3"}, "b": []string{"Float", "digits_integer_max:3"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 1000.0, "b": -1234.5}, rules)
+	if len(results.Errors["a"]) != 1 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Float digits integer max validator does not fail. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestFloatValidateDigitsFractionMaxPass(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_fraction_max:2"}, "b": []string{"Float", "digits_fraction_max:2"},
+		"c": []string{"Float", "digits_fraction_max:2"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 0.1, "b": -19.99, "c": 1e20}, rules)
+	if !results.IsValid {
+		t.Errorf("Float digits fraction max validator does not pass. Errors: %v", results.Errors)
+	}
+}
+func TestFloatValidateDigitsFractionMaxFail(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_fraction_max:2"}, "b": []string{"Float", "digits_fraction_max:2"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 0.125, "b": 1e-7}, rules)
+	if len(results.Errors["a"]) != 1 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Float digits fraction max validator does not fail. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestFloatValidateDigitsSignificantMaxPass(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_significant_max:2"}, "b": []string{"Float", "digits_significant_max:2"},
+		"c": []string{"Float", "digits_significant_max:2"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 1200.0, "b": 0.0012, "c": 0.0}, rules)
+	if !results.IsValid {
+		t.Errorf("Float digits significant max validator does not pass. Errors: %v", results.Errors)
+	}
+}
+func TestFloatValidateDigitsSignificantMaxFail(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Float", "digits_significant_max:2"}, "b": []string{"Float", "digits_significant_max:2"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 1201.0, "b": -0.00123}, rules)
+	if len(results.Errors["a"]) != 1 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Float digits significant max validator does not fail. Errors: %v", results.Errors)
+	}
+}
+
+
+
 func TestFloatValidateMaxPass(t *testing.T) {
 	data := TestStruct{Baz: 4}
 	rules := ValidationRules{"Baz": []string{"Float", "max:5"}}
@@ -145,3 +225,45 @@ func TestFloatValidateMinFail(t *testing.T) {
 		t.Errorf("Float min validator does not fail.")
 	}
 }
+
+
+
+func TestFloatValidateInPass(t *testing.T) {
+	data := TestStruct{Baz: 0.5}
+	rules := ValidationRules{"Baz": []string{"Float", "in:0.25,0.5,1"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Float in validator does not pass.")
+	}
+}
+func TestFloatValidateInFail(t *testing.T) {
+	data := TestStruct{Baz: 0.75}
+	rules := ValidationRules{"Baz": []string{"Float", "in:0.25,0.5,1"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Float in validator does not fail.")
+	}
+}
+
+
+
+func TestFloatValidateNotInPass(t *testing.T) {
+	data := TestStruct{Baz: 0.75}
+	rules := ValidationRules{"Baz": []string{"Float", "not_in:0.25,0.5,1"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Float not_in validator does not pass.")
+	}
+}
+func TestFloatValidateNotInFail(t *testing.T) {
+	data := TestStruct{Baz: 1}
+	rules := ValidationRules{"Baz": []string{"Float", "not_in:0.25,0.5,1"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Float not_in validator does not fail.")
+	}
+}
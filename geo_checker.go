@@ -0,0 +1,196 @@
+package validity
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A point on the Earth, in degrees. This is what the LatLng type puts in the results Data.
+type LatLng struct {
+	Lat float64
+	Lng float64
+}
+
+// Checks coordinates, for the LatLng type. Values are strings like "51.5074,-0.1278", or maps with "lat" and "lng"
+// keys ("latitude", "longitude" and "lon" work too, in any case), with the latitude within ±90 and the longitude
+// within ±180.
+type LatLngValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  LatLng
+}
+
+// The mean radius of the Earth, in kilometres.
+const earthRadius = 6371.0088
+
+func isLatitude(f float64) bool {
+	return f >= -90 && f <= 90
+}
+
+func isLongitude(f float64) bool {
+	return f >= -180 && f <= 180
+}
+
+// Finds one of the keys in the map, ignoring case so that structs converted to maps work, and converts it to a float.
+// The keys are tried in the order given, and an exact match wins over one in another case, so that a map with both
+// "lat" and "Latitude" always gives the same point. Any map with string keys works, like a map[string]float64.
+func coordinateIn(data reflect.Value, keys ...string) (float64, bool) {
+	names := data.MapKeys()
+	sort.Slice(names, func(i, j int) bool { return names[i].String() < names[j].String() })
+
+	for _, key := range keys {
+		for _, exact := range []bool{true, false} {
+			for _, name := range names {
+				if exact && name.String() == key || !exact && strings.EqualFold(name.String(), key) {
+					out, err := toFloat(data.MapIndex(name).Interface())
+					return out, err == nil
+				}
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// Converts the value to a point, checking that it's in range.
+func toLatLng(value interface{}) (LatLng, bool) {
+	var point LatLng
+	var latOk, lngOk bool
+
+	switch val := value.(type) {
+	case LatLng:
+		point, latOk, lngOk = val, true, true
+	default:
+		if data := reflect.ValueOf(value); data.Kind() == reflect.Map && data.Type().Key().Kind() == reflect.String {
+			point.Lat, latOk = coordinateIn(data, "lat", "latitude")
+			point.Lng, lngOk = coordinateIn(data, "lng", "lon", "longitude")
+			break
+		}
+
+		parts := strings.Split(fmt.Sprintf("%v", value), ",")
+		if len(parts) != 2 {
+			return point, false
+		}
+
+		var err error
+		point.Lat, err = strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+		latOk = err == nil
+		point.Lng, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		lngOk = err == nil
+	}
+
+	return point, latOk && lngOk && isLatitude(point.Lat) && isLongitude(point.Lng)
+}
+
+// Returns the great circle distance between the points in kilometres, using the haversine formula.
+func haversine(a LatLng, b LatLng) float64 {
+	rad  := math.Pi / 180
+	dLat := (b.Lat - a.Lat) * rad
+	dLng := (b.Lng - a.Lng) * rad
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(a.Lat*rad)*math.Cos(b.Lat*rad)*math.Pow(math.Sin(dLng/2), 2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Converts rule arguments to floats, returning false if any can't be.
+func (v LatLngValidityChecker) toFloats(args []string) ([]float64, bool) {
+	out := make([]float64, len(args))
+
+	for i, arg := range args {
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, false
+		}
+		out[i] = f
+	}
+
+	return out, true
+}
+
+func (v LatLngValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v LatLngValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v LatLngValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v LatLngValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes if the point is inside the box, like "within_bbox:49.9,-8.6,60.9,1.8". If the minimum longitude is greater
+// than the maximum, the box crosses the antimeridian.
+func (v LatLngValidityChecker) ValidateWithinBbox(args ...string) bool {
+	box, ok := v.toFloats(args)
+	if !ok || len(box) != 4 {
+		return false
+	}
+
+	minLat, minLng, maxLat, maxLng := box[0], box[1], box[2], box[3]
+	if v.Item.Lat < minLat || v.Item.Lat > maxLat {
+		return false
+	}
+
+	if minLng <= maxLng {
+		return v.Item.Lng >= minLng && v.Item.Lng <= maxLng
+	}
+
+	return v.Item.Lng >= minLng || v.Item.Lng <= maxLng
+}
+
+// Passes if the point is no further than the given distance in kilometres from the centre, like
+// "within_radius:51.5074,-0.1278,25".
+func (v LatLngValidityChecker) ValidateWithinRadius(args ...string) bool {
+	circle, ok := v.toFloats(args)
+	if !ok || len(circle) != 3 {
+		return false
+	}
+
+	return haversine(v.Item, LatLng{Lat: circle[0], Lng: circle[1]}) <= circle[2]
+}
+
+func (v FloatValidityChecker) ValidateLatitude() bool {
+	return isLatitude(v.Item)
+}
+
+func (v FloatValidityChecker) ValidateLongitude() bool {
+	return isLongitude(v.Item)
+}
+
+func (v IntValidityChecker) ValidateLatitude() bool {
+	return v.Item >= -90 && v.Item <= 90
+}
+
+func (v IntValidityChecker) ValidateLongitude() bool {
+	return v.Item >= -180 && v.Item <= 180
+}
+
+func (v DecimalValidityChecker) ValidateLatitude() bool {
+	return v.ValidateBetween("-90", "90")
+}
+
+func (v DecimalValidityChecker) ValidateLongitude() bool {
+	return v.ValidateBetween("-180", "180")
+}
+
+func (v UintValidityChecker) ValidateLatitude() bool {
+	return v.Item <= 90
+}
+
+func (v UintValidityChecker) ValidateLongitude() bool {
+	return v.Item <= 180
+}
@@ -0,0 +1,254 @@
+package validity
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestToLatLng(t *testing.T) {
+	pass := []interface{}{
+		"51.5074,-0.1278",
+		" 51.5074 , -0.1278 ",
+		map[string]interface{}{"lat": 51.5074, "lng": -0.1278},
+		map[string]interface{}{"latitude": "51.5074", "longitude": json.Number("-0.1278")},
+		map[string]interface{}{"Lat": 51.5074, "Lon": -0.1278},
+		map[string]float64{"lat": 51.5074, "lng": -0.1278},
+		map[string]string{"LAT": "51.5074", "longitude": "-0.1278"},
+		map[string]interface{}{"lat": 51.5074, "latitude": 10, "lng": -0.1278},
+		map[string]interface{}{"Lat": 10, "lat": 51.5074, "lng": -0.1278},
+		LatLng{Lat: 51.5074, Lng: -0.1278},
+	}
+
+	for _, input := range pass {
+		if actual, ok := toLatLng(input); !ok || actual != (LatLng{Lat: 51.5074, Lng: -0.1278}) {
+			t.Errorf("%#v should convert to a point, got %v.", input, actual)
+		}
+	}
+
+	fail := []interface{}{
+		"51.5074",
+		"51.5074,-0.1278,3",
+		"91,0",
+		"0,180.5",
+		"north,west",
+		"NaN,0",
+		map[string]interface{}{"lat": 51.5074},
+		map[string]interface{}{"lat": "x", "lng": 0},
+		map[int]float64{0: 51.5074, 1: -0.1278},
+		42,
+	}
+
+	for _, input := range fail {
+		if actual, ok := toLatLng(input); ok {
+			t.Errorf("%#v should not convert to a point, got %v.", input, actual)
+		}
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	london := LatLng{Lat: 51.5074, Lng: -0.1278}
+	paris  := LatLng{Lat: 48.8566, Lng: 2.3522}
+
+	if distance := haversine(london, paris); math.Abs(distance - 343.5) > 1 {
+		t.Errorf("London to Paris should be about 343.5km, got %v.", distance)
+	}
+
+	if distance := haversine(london, london); distance != 0 {
+		t.Errorf("The distance from a point to itself should be zero, got %v.", distance)
+	}
+}
+
+
+
+func TestLatLngType(t *testing.T) {
+	data := map[string]interface{}{"home": "51.5074,-0.1278", "bad": "95,0", "point": map[string]interface{}{"lat": 1, "lng": 2}}
+	rules := ValidationRules{"home": []string{"LatLng"}, "bad": []string{"LatLng"}, "point": []string{"LatLng"}}
+
+	results := ValidateMap(data, rules)
+	if results.Data["home"] != (LatLng{Lat: 51.5074, Lng: -0.1278}) || results.Data["point"] != (LatLng{Lat: 1, Lng: 2}) ||
+		results.Errors["bad"][0] != "LatLng" {
+		t.Errorf("LatLng type does not convert. Results: %v", results)
+	}
+}
+
+
+
+func TestLatLngValidateWithinBbox(t *testing.T) {
+	data := map[string]interface{}{"london": "51.5074,-0.1278", "paris": "48.8566,2.3522", "fiji": "-17.7,179.5",
+		"samoa": "-13.8,-172.1", "perth": "-31.95,115.86"}
+	uk      := "within_bbox:49.9,-8.6,60.9,1.8"
+	pacific := "within_bbox:-25,170,-10,-170"
+	rules   := ValidationRules{
+		"london": []string{"LatLng", uk},
+		"paris":  []string{"LatLng", uk},
+		"fiji":   []string{"LatLng", pacific},
+		"samoa":  []string{"LatLng", pacific},
+		"perth":  []string{"LatLng", pacific, "within_bbox:1,2"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["london"]) != 0 || len(results.Errors["paris"]) != 1 || len(results.Errors["fiji"]) != 0 ||
+		len(results.Errors["samoa"]) != 0 || len(results.Errors["perth"]) != 2 {
+		t.Errorf("LatLng within bbox validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestLatLngValidateWithinRadius(t *testing.T) {
+	data  := map[string]interface{}{"london": "51.5074,-0.1278", "paris": "48.8566,2.3522"}
+	rule  := "within_radius:51.5,-0.12,25"
+	rules := ValidationRules{"london": []string{"LatLng", rule}, "paris": []string{"LatLng", rule, "within_radius:x"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["london"]) != 0 || len(results.Errors["paris"]) != 2 {
+		t.Errorf("LatLng within radius validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestValidateLatitudePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		for _, value := range []interface{}{0, -90, 90, 45} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s latitude validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLatitudeFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		for _, value := range []interface{}{91, -91, 180} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s latitude validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLatitudeFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 89.999}
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s latitude validator does not pass.", kind)
+		}
+	}
+}
+func TestValidateLatitudeFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 90.0001}
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s latitude validator does not fail.", kind)
+		}
+	}
+}
+func TestValidateLatitudeUintPass(t *testing.T) {
+	for _, kind := range []string{"Uint", "Uint8", "Uint16", "Uint32", "Uint64", "Uintptr"} {
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		for _, value := range []interface{}{0, 45, 90} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s latitude validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLatitudeUintFail(t *testing.T) {
+	for _, kind := range []string{"Uint", "Uint8", "Uint16", "Uint32", "Uint64", "Uintptr"} {
+		rules := ValidationRules{"foo": []string{kind, "latitude"}}
+
+		for _, value := range []interface{}{91, 255} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s latitude validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+
+
+
+func TestValidateLongitudePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		for _, value := range []interface{}{0, -180, 180, 91} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s longitude validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLongitudeFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		for _, value := range []interface{}{181, -181} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s longitude validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLongitudeFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": -179.999}
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s longitude validator does not pass.", kind)
+		}
+	}
+}
+func TestValidateLongitudeFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 180.0001}
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s longitude validator does not fail.", kind)
+		}
+	}
+}
+func TestValidateLongitudeUintPass(t *testing.T) {
+	for _, kind := range []string{"Uint", "Uint8", "Uint16", "Uint32", "Uint64", "Uintptr"} {
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		for _, value := range []interface{}{0, 91, 180} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s longitude validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestValidateLongitudeUintFail(t *testing.T) {
+	for _, kind := range []string{"Uint", "Uint8", "Uint16", "Uint32", "Uint64", "Uintptr"} {
+		rules := ValidationRules{"foo": []string{kind, "longitude"}}
+
+		for _, value := range []interface{}{181, 255} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s longitude validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package validity
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Hangul jamo and syllable classes, which decide how Korean characters join into a single grapheme.
+const (
+	hangulNone = iota
+	hangulL
+	hangulV
+	hangulT
+	hangulLV
+	hangulLVT
+)
+
+// Counts the user-perceived characters in the string. This follows the main rules of extended grapheme clusters from
+// Unicode Standard Annex #29: combining marks, emoji modifiers and ZWJ sequences, flag pairs and Hangul syllables all
+// count as one character along with whatever they attach to. It doesn't handle the rarer prepend characters, so a
+// handful of scripts may count slightly high, but it's far closer than counting runes.
+func graphemeCount(s string) int {
+	count     := 0
+	prev      := rune(-1)
+	regionals := 0
+
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+
+		if prev >= 0 && joinsGrapheme(prev, r, regionals) {
+			if isRegionalIndicator(r) {
+				regionals++
+			}
+			prev = r
+			continue
+		}
+
+		count++
+		regionals = 0
+		if isRegionalIndicator(r) {
+			regionals = 1
+		}
+		prev = r
+	}
+
+	return count
+}
+
+// Returns whether r continues the grapheme which prev is part of. regionals is how many regional indicators are at
+// the end of the current grapheme, so that flags pair up.
+func joinsGrapheme(prev rune, r rune, regionals int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return true
+	case isControl(prev) || isControl(r):
+		return false
+	case isGraphemeExtend(r):
+		return true
+	case prev == '\u200d' && unicode.Is(unicode.So, r):
+		return true
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		return regionals % 2 == 1
+	}
+
+	switch hangulClass(prev) {
+	case hangulL:
+		class := hangulClass(r)
+		return class == hangulL || class == hangulV || class == hangulLV || class == hangulLVT
+	case hangulLV, hangulV:
+		class := hangulClass(r)
+		return class == hangulV || class == hangulT
+	case hangulLVT, hangulT:
+		return hangulClass(r) == hangulT
+	}
+
+	return false
+}
+
+// Controls and line breaks always stand alone.
+func isControl(r rune) bool {
+	return unicode.Is(unicode.Cc, r) || r == '\u2028' || r == '\u2029'
+}
+
+// Marks, joiners, emoji modifiers and tag characters extend whatever comes before them.
+func isGraphemeExtend(r rune) bool {
+	return unicode.Is(unicode.M, r) ||
+		r == '\u200d' || r == '\u200c' ||
+		(r >= 0x1f3fb && r <= 0x1f3ff) ||
+		(r >= 0xe0020 && r <= 0xe007f)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
+
+func hangulClass(r rune) int {
+	switch {
+	case r >= 0x1100 && r <= 0x115f, r >= 0xa960 && r <= 0xa97c:
+		return hangulL
+	case r >= 0x1160 && r <= 0x11a7, r >= 0xd7b0 && r <= 0xd7c6:
+		return hangulV
+	case r >= 0x11a8 && r <= 0x11ff, r >= 0xd7cb && r <= 0xd7fb:
+		return hangulT
+	case r >= 0xac00 && r <= 0xd7a3:
+		if (r - 0xac00) % 28 == 0 {
+			return hangulLV
+		}
+		return hangulLVT
+	}
+
+	return hangulNone
+}
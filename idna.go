@@ -0,0 +1,155 @@
+package validity
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Converting internationalised domain names, like "bücher.de", to their ASCII form, like "xn--bcher-kva.de", so they
+// can be checked as hostnames and looked up in DNS. Each label with anything but ASCII in it is lowercased and
+// Punycode encoded, per RFC 3492. This doesn't do the full IDNA mapping, like Unicode normalisation, so names should
+// be given in their usual composed form.
+
+const (
+	punycodeBase        = 36
+	punycodeTmin        = 1
+	punycodeTmax        = 26
+	punycodeSkew        = 38
+	punycodeDamp        = 700
+	punycodeInitialBias = 72
+	punycodeInitialN    = 128
+)
+
+// Converts the domain to ASCII, label by label. Domains which are ASCII already are returned untouched. ok is false if
+// the domain isn't valid UTF-8 or a label can't be encoded.
+func domainToASCII(domain string) (string, bool) {
+	if isASCII(domain) {
+		return domain, true
+	}
+	if !utf8.ValidString(domain) {
+		return "", false
+	}
+
+	labels := strings.Split(domain, ".")
+	for i, label := range labels {
+		if isASCII(label) {
+			continue
+		}
+
+		encoded, ok := punycodeEncode(strings.ToLower(label))
+		if !ok {
+			return "", false
+		}
+		labels[i] = "xn--" + encoded
+	}
+
+	return strings.Join(labels, "."), true
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Encodes the string with Punycode, as in RFC 3492 section 6.3. Strings too long to be a domain aren't ok.
+func punycodeEncode(s string) (string, bool) {
+	if len(s) > 253 {
+		return "", false
+	}
+
+	runes := []rune(s)
+	out   := []byte{}
+	for _, r := range runes {
+		if r < utf8.RuneSelf {
+			out = append(out, byte(r))
+		}
+	}
+
+	basic   := len(out)
+	handled := basic
+	if basic > 0 {
+		out = append(out, '-')
+	}
+
+	n     := rune(punycodeInitialN)
+	bias  := punycodeInitialBias
+	delta := 0
+
+	for handled < len(runes) {
+		next := rune(utf8.MaxRune)
+		for _, r := range runes {
+			if r >= n && r < next {
+				next = r
+			}
+		}
+
+		delta += int(next-n) * (handled + 1)
+		n      = next
+
+		for _, r := range runes {
+			if r < n {
+				delta++
+			}
+			if r != n {
+				continue
+			}
+
+			q := delta
+			for k := punycodeBase; ; k += punycodeBase {
+				t := k - bias
+				if t < punycodeTmin {
+					t = punycodeTmin
+				} else if t > punycodeTmax {
+					t = punycodeTmax
+				}
+				if q < t {
+					break
+				}
+				out = append(out, punycodeDigit(t + (q-t)%(punycodeBase-t)))
+				q   = (q - t) / (punycodeBase - t)
+			}
+			out = append(out, punycodeDigit(q))
+
+			bias  = punycodeAdapt(delta, handled+1, handled == basic)
+			delta = 0
+			handled++
+		}
+
+		delta++
+		n++
+	}
+
+	return string(out), true
+}
+
+// Adapts the bias after each character is encoded, as in RFC 3492 section 6.1.
+func punycodeAdapt(delta int, points int, first bool) int {
+	if first {
+		delta /= punycodeDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / points
+
+	k := 0
+	for delta > (punycodeBase-punycodeTmin)*punycodeTmax/2 {
+		delta /= punycodeBase - punycodeTmin
+		k     += punycodeBase
+	}
+
+	return k + (punycodeBase-punycodeTmin+1)*delta/(delta+punycodeSkew)
+}
+
+// Returns the character for a digit from 0 to 35: a to z, then 0 to 9.
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+
+	return byte('0' + d - 26)
+}
@@ -0,0 +1,26 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestDomainToASCII(t *testing.T) {
+	tests := map[string]string{
+		"example.com":     "example.com",
+		"bücher.de":       "xn--bcher-kva.de",
+		"münchen.example": "xn--mnchen-3ya.example",
+		"MÜNCHEN.example": "xn--mnchen-3ya.example",
+		"例え.テスト":          "xn--r8jz45g.xn--zckzah",
+		"ドメイン名例.jp":       "xn--eckwd4c7cu47r2wf.jp",
+	}
+
+	for input, expected := range tests {
+		if actual, ok := domainToASCII(input); !ok || actual != expected {
+			t.Errorf("%q should convert to %q, got %q.", input, expected, actual)
+		}
+	}
+
+	if actual, ok := domainToASCII("bad\xffname.com"); ok {
+		t.Errorf("Invalid UTF-8 should not convert, got %q.", actual)
+	}
+}
@@ -1,14 +1,20 @@
 package validity
 
 import (
+	"reflect"
 	"strconv"
-	"math"
+	"strings"
 )
 
+// Checks signed integers, for the Int, Int8, Int16, Int32 and Int64 types. Kind is the Go kind the item is given as in
+// the results Data. It's left as zero by the Int type, which gives an int64.
 type IntValidityChecker struct {
 	Key   string
 	Rules []string
 	Item  int64
+	Kind  reflect.Kind
+	// Input is the whole of the data under validation, for rules which depend on other fields.
+	Input map[string]interface{}
 }
 
 // Converts a string to an integer. That's all there is!
@@ -18,11 +24,9 @@ func (v IntValidityChecker) toInt(s string) int64 {
 	return out
 }
 
-// Gets the number of digits from the item.
+// Gets the number of digits from the item. Zero has one digit, and the sign isn't counted.
 func (v IntValidityChecker) getDigits() int64 {
-	log := math.Log10(float64(v.Item))
-
-	return int64(math.Ceil(log))
+	return int64(len(strings.TrimPrefix(strconv.FormatInt(v.Item, 10), "-")))
 }
 
 func (v IntValidityChecker) GetKey() string {
@@ -30,6 +34,10 @@ func (v IntValidityChecker) GetKey() string {
 }
 
 func (v IntValidityChecker) GetItem() interface{} {
+	if kind, ok := sizedTypes[v.Kind]; ok {
+		return reflect.ValueOf(v.Item).Convert(kind).Interface()
+	}
+
 	return v.Item
 }
 
@@ -50,7 +58,7 @@ func (v IntValidityChecker) ValidateAccepted() bool {
 }
 
 func (v IntValidityChecker) ValidateBetween(min string, max string) bool {
-	return v.Item > v.toInt(min) && v.Item < v.toInt(max)
+	return v.Item >= v.toInt(min) && v.Item <= v.toInt(max)
 }
 
 func (v IntValidityChecker) ValidateDigits(num string) bool {
@@ -60,7 +68,7 @@ func (v IntValidityChecker) ValidateDigits(num string) bool {
 func (v IntValidityChecker) ValidateDigitsBetween(min string, max string) bool {
 	digits := v.getDigits()
 
-	return digits > v.toInt(min) && digits < v.toInt(max)
+	return digits >= v.toInt(min) && digits <= v.toInt(max)
 }
 
 func (v IntValidityChecker) ValidateMax(max string) bool {
@@ -70,3 +78,23 @@ func (v IntValidityChecker) ValidateMax(max string) bool {
 func (v IntValidityChecker) ValidateMin(min string) bool {
 	return v.Item >= v.toInt(min)
 }
+
+func (v IntValidityChecker) ValidateIn(values ...string) bool {
+	for _, value := range values {
+		if out, err := strconv.ParseInt(value, 10, 64); err == nil && v.Item == out {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v IntValidityChecker) ValidateNotIn(values ...string) bool {
+	return !v.ValidateIn(values...)
+}
+
+func (v IntValidityChecker) ValidateEnum(name string) bool {
+	values, exists := enumValues(name)
+
+	return exists && v.ValidateIn(values...)
+}
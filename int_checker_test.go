@@ -106,6 +106,27 @@ func TestIntValidateDigitsBetweenFailUpper(t *testing.T) {
 
 
 
+func TestIntValidateDigitsExact(t *testing.T) {
+	tests := map[int64]string{0: "1", 7: "1", 100: "3", 999: "3", -100: "3", -9223372036854775808: "19"}
+
+	for value, digits := range tests {
+		results := ValidateMap(map[string]interface{}{"Bar": value}, ValidationRules{"Bar": []string{"Int", "digits:" + digits}})
+		if !results.IsValid {
+			t.Errorf("Int digits validator should count %d as %s digits.", value, digits)
+		}
+	}
+}
+func TestIntValidateDigitsBetweenInclusive(t *testing.T) {
+	rules := ValidationRules{"a": []string{"Int", "digits_between:2,4"}, "b": []string{"Int", "digits_between:2,4"}}
+
+	results := ValidateMap(map[string]interface{}{"a": 10, "b": -9999}, rules)
+	if !results.IsValid {
+		t.Errorf("Int digits between validator should include its bounds. Errors: %v", results.Errors)
+	}
+}
+
+
+
 func TestIntValidateMaxPass(t *testing.T) {
 	data := TestStruct{Bar: 4}
 	rules := ValidationRules{"Bar": []string{"Int", "max:5"}}
@@ -145,3 +166,45 @@ func TestIntValidateMinFail(t *testing.T) {
 		t.Errorf("Int min validator does not fail.")
 	}
 }
+
+
+
+func TestIntValidateInPass(t *testing.T) {
+	data := TestStruct{Bar: 2}
+	rules := ValidationRules{"Bar": []string{"Int", "in:1,2,3"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Int in validator does not pass.")
+	}
+}
+func TestIntValidateInFail(t *testing.T) {
+	data := TestStruct{Bar: 4}
+	rules := ValidationRules{"Bar": []string{"Int", "in:1,2,3"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Int in validator does not fail.")
+	}
+}
+
+
+
+func TestIntValidateNotInPass(t *testing.T) {
+	data := TestStruct{Bar: 4}
+	rules := ValidationRules{"Bar": []string{"Int", "not_in:1,2,3"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("Int not_in validator does not pass.")
+	}
+}
+func TestIntValidateNotInFail(t *testing.T) {
+	data := TestStruct{Bar: 1}
+	rules := ValidationRules{"Bar": []string{"Int", "not_in:1,2,3"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("Int not_in validator does not fail.")
+	}
+}
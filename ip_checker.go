@@ -0,0 +1,150 @@
+package validity
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+type IPValidityChecker struct {
+	Key   string
+	Rules []string
+	// Item is what ends up in the results Data: a netip.Prefix if the input was in CIDR notation, a netip.AddrPort if
+	// it had a port, or a netip.Addr otherwise.
+	Item  interface{}
+	// The address, which for a prefix is the address it was written with.
+	Addr  netip.Addr
+	// The prefix, if the input was in CIDR notation like "10.0.0.0/8". Otherwise it is the zero value.
+	Prefix netip.Prefix
+	// The port, if the input had one like "127.0.0.1:8080" or "[::1]:8080". HasPort says whether it did.
+	Port    uint16
+	HasPort bool
+}
+
+// Parses the IP as an address, an address and port, or a prefix in CIDR notation.
+func parseIPChecker(s string) (IPValidityChecker, bool) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		return IPValidityChecker{Item: prefix, Addr: prefix.Addr(), Prefix: prefix}, err == nil
+	}
+
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return IPValidityChecker{Item: addr, Addr: addr}, true
+	}
+
+	addrPort, err := netip.ParseAddrPort(s)
+	return IPValidityChecker{Item: addrPort, Addr: addrPort.Addr(), Port: addrPort.Port(), HasPort: true}, err == nil
+}
+
+// Parses the subnet arguments of a rule. Invalid subnets are skipped.
+func parseSubnets(subnets []string) []netip.Prefix {
+	prefixes := []netip.Prefix{}
+
+	for _, subnet := range subnets {
+		if prefix, err := netip.ParsePrefix(subnet); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+		}
+	}
+
+	return prefixes
+}
+
+// Converts a string to a port number, or -1 if it is not one.
+func toPort(s string) int {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return -1
+	}
+
+	return int(port)
+}
+
+func (v IPValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v IPValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v IPValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v IPValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v IPValidityChecker) ValidateCidr() bool {
+	return v.Prefix.IsValid()
+}
+
+// Passes for IPv4 addresses, including ones mapped into IPv6 like "::ffff:127.0.0.1".
+func (v IPValidityChecker) ValidateIpv4() bool {
+	return v.Addr.Unmap().Is4()
+}
+
+// Passes for IPv6 addresses, but not IPv4 addresses mapped into IPv6.
+func (v IPValidityChecker) ValidateIpv6() bool {
+	return v.Addr.Is6() && !v.Addr.Is4In6()
+}
+
+// Passes if the address is in any of the given subnets. For a prefix, the whole prefix must fit inside the subnet.
+func (v IPValidityChecker) ValidateInSubnet(subnets ...string) bool {
+	addr := v.Addr.Unmap()
+
+	for _, subnet := range parseSubnets(subnets) {
+		if subnet.Contains(addr) && (!v.Prefix.IsValid() || v.Prefix.Bits() >= subnet.Bits()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the address is in none of the given subnets. For a prefix, no part of it may overlap any subnet.
+func (v IPValidityChecker) ValidateNotInSubnet(subnets ...string) bool {
+	addr := v.Addr.Unmap()
+
+	for _, subnet := range parseSubnets(subnets) {
+		if subnet.Contains(addr) {
+			return false
+		}
+		if v.Prefix.IsValid() && subnet.Overlaps(v.Prefix) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v IPValidityChecker) ValidateLoopback() bool {
+	return v.Addr.Unmap().IsLoopback()
+}
+
+func (v IPValidityChecker) ValidateMulticast() bool {
+	return v.Addr.Unmap().IsMulticast()
+}
+
+func (v IPValidityChecker) ValidatePrivate() bool {
+	return v.Addr.Unmap().IsPrivate()
+}
+
+// Passes if the address is reachable on the public internet. See the URL public_url rule for what is excluded.
+func (v IPValidityChecker) ValidatePublic() bool {
+	return isPublicAddr(v.Addr)
+}
+
+func (v IPValidityChecker) ValidatePort() bool {
+	return v.HasPort
+}
+
+func (v IPValidityChecker) ValidatePortBetween(min string, max string) bool {
+	port, low, high := int(v.Port), toPort(min), toPort(max)
+
+	return v.HasPort && low >= 0 && high >= 0 && port >= low && port <= high
+}
@@ -0,0 +1,124 @@
+package validity
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPParses(t *testing.T) {
+	data := map[string]interface{}{"a": "127.0.0.1", "b": "10.0.0.0/8", "c": "[::1]:8080"}
+	rules := ValidationRules{"a": []string{"IP"}, "b": []string{"IP"}, "c": []string{"IP"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid ||
+		results.Data["a"] != netip.MustParseAddr("127.0.0.1") ||
+		results.Data["b"] != netip.MustParsePrefix("10.0.0.0/8") ||
+		results.Data["c"] != netip.MustParseAddrPort("[::1]:8080") {
+		t.Errorf("IP type does not parse. Results: %v", results)
+	}
+}
+func TestIPRejectsInvalid(t *testing.T) {
+	data := map[string]interface{}{"a": "127.foo.bar.1", "b": "10.0.0.0/33", "c": "1.2.3.4:99999"}
+	rules := ValidationRules{"a": []string{"IP"}, "b": []string{"IP"}, "c": []string{"IP"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors) != 3 || results.Errors["a"][0] != "IP" {
+		t.Errorf("IP type does not reject invalid addresses. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestIPValidateIpv4Ipv6(t *testing.T) {
+	data := map[string]interface{}{"a": "127.0.0.1", "b": "::1", "c": "::ffff:127.0.0.1", "d": "::1"}
+	rules := ValidationRules{"a": []string{"IP", "ipv4"}, "b": []string{"IP", "ipv6"}, "c": []string{"IP", "ipv6"}, "d": []string{"IP", "ipv4"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 0 || len(results.Errors["c"]) != 1 || len(results.Errors["d"]) != 1 {
+		t.Errorf("IP ipv4 and ipv6 validators do not discriminate. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestIPValidateCidr(t *testing.T) {
+	data := map[string]interface{}{"a": "10.0.0.0/8", "b": "10.0.0.1"}
+	rules := ValidationRules{"a": []string{"IP", "cidr"}, "b": []string{"IP", "cidr"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("IP cidr validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestIPValidateInSubnet(t *testing.T) {
+	data := map[string]interface{}{"a": "10.1.2.3", "b": "10.1.0.0/16", "c": "11.0.0.1", "d": "10.0.0.0/7"}
+	rule := "in_subnet:10.0.0.0/8,192.168.0.0/16"
+	rules := ValidationRules{"a": []string{"IP", rule}, "b": []string{"IP", rule}, "c": []string{"IP", rule}, "d": []string{"IP", rule}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 0 || len(results.Errors["c"]) != 1 || len(results.Errors["d"]) != 1 {
+		t.Errorf("IP in_subnet validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestIPValidateNotInSubnet(t *testing.T) {
+	data := map[string]interface{}{"a": "11.0.0.1", "b": "10.1.2.3", "c": "8.0.0.0/6"}
+	rule := "not_in_subnet:10.0.0.0/8"
+	rules := ValidationRules{"a": []string{"IP", rule}, "b": []string{"IP", rule}, "c": []string{"IP", rule}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 {
+		t.Errorf("IP not_in_subnet validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestIPValidateRanges(t *testing.T) {
+	data := map[string]interface{}{
+		"private":   "192.168.1.1",
+		"public":    "8.8.8.8",
+		"loopback":  "::1",
+		"multicast": "224.0.0.1",
+		"notpublic": "169.254.169.254",
+	}
+	rules := ValidationRules{
+		"private":   []string{"IP", "private"},
+		"public":    []string{"IP", "public"},
+		"loopback":  []string{"IP", "loopback"},
+		"multicast": []string{"IP", "multicast"},
+		"notpublic": []string{"IP", "public"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors) != 1 || len(results.Errors["notpublic"]) != 1 {
+		t.Errorf("IP range validators do not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestIPValidatePortBetween(t *testing.T) {
+	data := map[string]interface{}{"a": "127.0.0.1:8080", "b": "127.0.0.1:80", "c": "127.0.0.1", "d": "127.0.0.1:8080",
+		"e": "127.0.0.1:8080"}
+	rule := "port_between:1024,65535"
+	rules := ValidationRules{"a": []string{"IP", rule}, "b": []string{"IP", rule}, "c": []string{"IP", rule},
+		"d": []string{"IP", "port_between:x,65535"}, "e": []string{"IP", "port_between:1024,70000"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 ||
+		len(results.Errors["d"]) != 1 || len(results.Errors["e"]) != 1 {
+		t.Errorf("IP port_between validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestIPValidatePort(t *testing.T) {
+	data := map[string]interface{}{"a": "127.0.0.1:8080", "b": "127.0.0.1"}
+	rules := ValidationRules{"a": []string{"IP", "port"}, "b": []string{"IP", "port"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("IP port validator does not work. Errors: %v", results.Errors)
+	}
+}
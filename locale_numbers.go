@@ -0,0 +1,176 @@
+package validity
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Parsing of numbers written for a locale, like "1.234,56" in German, so form input can be validated directly. Values
+// are rewritten into Go syntax before the parser sees them, and strings are the only values touched.
+
+//go:embed data/number_formats.txt
+var numberFormatsData string
+
+var (
+	numberFormatsOnce sync.Once
+	numberFormats     map[string]numberFormat
+)
+
+// The types which are numbers, and so are affected by the "locale" rule.
+var numericTypes = map[string]bool{
+	"Int": true, "Int8": true, "Int16": true, "Int32": true, "Int64": true, "Uint": true, "Uint8": true,
+	"Uint16": true, "Uint32": true, "Uint64": true, "Uintptr": true, "Float": true, "Decimal": true,
+}
+
+// How numbers are written in a locale. See data/number_formats.txt.
+type numberFormat struct {
+	decimal    string
+	separators map[rune]bool
+	lastGroup  int
+	groups     int
+}
+
+func loadNumberFormats() {
+	numberFormats = map[string]numberFormat{}
+
+	for _, fields := range referenceLines(numberFormatsData) {
+		format := numberFormat{decimal: fields[1], separators: map[rune]bool{}}
+
+		sizes := strings.Split(fields[2], ",")
+		format.lastGroup, _ = strconv.Atoi(sizes[0])
+		format.groups, _    = strconv.Atoi(sizes[len(sizes)-1])
+
+		for _, separator := range fields[3:] {
+			switch separator {
+			case "space":
+				format.separators[' '], format.separators['\u00a0'], format.separators['\u202f'] = true, true, true
+			case "apos":
+				format.separators['\''], format.separators['\u2019'] = true, true
+			default:
+				format.separators[[]rune(separator)[0]] = true
+			}
+		}
+
+		numberFormats[strings.ToLower(fields[0])] = format
+	}
+}
+
+// Finds the number format for the locale, trying the full tag and then the language alone. Underscores are accepted
+// in place of hyphens, like "de_CH".
+func findNumberFormat(locale string) (numberFormat, bool) {
+	numberFormatsOnce.Do(loadNumberFormats)
+
+	tag := strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	if format, ok := numberFormats[tag]; ok {
+		return format, true
+	}
+
+	format, ok := numberFormats[strings.SplitN(tag, "-", 2)[0]]
+
+	return format, ok
+}
+
+// Rewrites a number written in the format into Go syntax, like "-1234.56". Grouping separators must split the digits
+// into groups of the right sizes, so "1.5" isn't misread as fifteen in German. If grouping is false, they aren't
+// allowed at all.
+func (f numberFormat) normalize(s string, grouping bool) (string, bool) {
+	s    = strings.TrimSpace(s)
+	sign := ""
+
+	switch {
+	case strings.HasPrefix(s, "-"), strings.HasPrefix(s, "+"):
+		sign, s = strings.TrimPrefix(s[:1], "+"), s[1:]
+	case strings.HasPrefix(s, "\u2212"):
+		sign, s = "-", strings.TrimPrefix(s, "\u2212")
+	}
+
+	parts := strings.Split(s, f.decimal)
+	if len(parts) > 2 {
+		return "", false
+	}
+
+	// Separators may only come between groups, so there must be one fewer than there are groups.
+	groups     := strings.FieldsFunc(parts[0], func(r rune) bool { return f.separators[r] })
+	separators := countSeparators(parts[0], f.separators)
+	if len(groups) > 1 && !grouping || len(groups) > 0 && separators != len(groups)-1 || len(groups) == 0 && separators > 0 {
+		return "", false
+	}
+
+	for i, group := range groups {
+		switch {
+		case !isDigits(group):
+			return "", false
+		case len(groups) == 1:
+		case i == len(groups)-1 && len(group) != f.lastGroup:
+			return "", false
+		case i > 0 && i < len(groups)-1 && len(group) != f.groups:
+			return "", false
+		case i == 0 && len(group) > f.groups:
+			return "", false
+		}
+	}
+
+	out := sign + strings.Join(groups, "")
+	if len(parts) == 1 {
+		return out, len(groups) > 0
+	}
+
+	if !isDigits(parts[1]) && !(parts[1] == "" && len(groups) > 0) {
+		return "", false
+	}
+
+	return out + "." + parts[1], true
+}
+
+func countSeparators(s string, separators map[rune]bool) int {
+	count := 0
+	for _, r := range s {
+		if separators[r] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Returns the locale numbers are written for under the rules, from the locale rule or else the options, or "" if
+// there isn't one.
+func (c *ValidityQueue) numberLocale(rules []string) string {
+	if locale, found := findRuleArgument("locale", rules[1:]); found {
+		return locale
+	}
+
+	return c.Options.Locale
+}
+
+// Returns whether the item is a string holding a number written for a locale. In strict mode these are let through
+// for the numeric types, as numbers like "1.234,56" can only be written as strings.
+func (c *ValidityQueue) isLocalizedNumber(item interface{}, rules []string) bool {
+	_, isString := item.(string)
+
+	return isString && numericTypes[rules[0]] && c.numberLocale(rules) != ""
+}
+
+// Rewrites the value into Go syntax if a locale applies to it, from a "locale" rule or else from Options.Locale. ok is
+// false if the value can't be read in the locale, or the locale is unknown. Without a locale, values are left alone,
+// as Go syntax has no grouping separators anyway.
+func (c *ValidityQueue) localizeNumber(item interface{}, rules []string) (interface{}, bool) {
+	s, isString := item.(string)
+	if !isString || !numericTypes[rules[0]] {
+		return item, true
+	}
+
+	locale := c.numberLocale(rules)
+	if locale == "" {
+		return item, true
+	}
+
+	format, ok := findNumberFormat(locale)
+	if !ok {
+		return item, false
+	}
+
+	return format.normalize(s, !c.Options.RejectGrouping && !inSlice("no_grouping", rules[1:]))
+}
@@ -0,0 +1,102 @@
+package validity
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestNumberFormatNormalize(t *testing.T) {
+	tests := []struct {
+		Locale string
+		Pass   map[string]string
+		Fail   []string
+	}{
+		{"de", map[string]string{"1.234,56": "1234.56", "1234,5": "1234.5", "-1.000.000": "-1000000", "0,5": "0.5",
+			",5": ".5", "+12": "12", "\u22123": "-3", " 42 ": "42"},
+			[]string{"1.5", "1.23", "1,2,3", "1..000", ".100", "100.", "1.0000", "12a", "", "-", ","}},
+		{"fr", map[string]string{"12 500": "12500", "12\u00a0500,25": "12500.25", "1\u202f000\u202f000": "1000000"},
+			[]string{"12 50", "12.500", "12  500"}},
+		{"de-CH", map[string]string{"1'234.50": "1234.50", "1\u2019234": "1234"}, []string{"1.234,50"}},
+		{"en-IN", map[string]string{"1,23,45,678": "12345678", "12,345": "12345", "999": "999"},
+			[]string{"1,234,567", "123,45,678"}},
+		{"en_US", map[string]string{"1,234.5": "1234.5"}, []string{"1.234,5"}},
+	}
+
+	for _, test := range tests {
+		format, ok := findNumberFormat(test.Locale)
+		if !ok {
+			t.Fatalf("Number format for %s was not found.", test.Locale)
+		}
+
+		for input, expected := range test.Pass {
+			if actual, ok := format.normalize(input, true); !ok || actual != expected {
+				t.Errorf("%q in %s should read as %q, got %q.", input, test.Locale, expected, actual)
+			}
+		}
+
+		for _, input := range test.Fail {
+			if actual, ok := format.normalize(input, true); ok {
+				t.Errorf("%q should not read as a number in %s, got %q.", input, test.Locale, actual)
+			}
+		}
+	}
+
+	if _, ok := findNumberFormat("xx"); ok {
+		t.Errorf("Unknown locales should not have a number format.")
+	}
+}
+
+
+
+func TestLocaleRule(t *testing.T) {
+	data := map[string]interface{}{"price": "1.234,56", "qty": "12 500", "count": 7, "plain": "1234.5"}
+	rules := ValidationRules{
+		"price": []string{"Decimal", "locale:de", "max:2000"},
+		"qty":   []string{"Int", "locale:fr"},
+		"count": []string{"Int", "locale:de"},
+		"plain": []string{"Float"},
+	}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["price"].(*big.Rat).FloatString(2) != "1234.56" ||
+		results.Data["qty"] != int64(12500) || results.Data["count"] != int64(7) || results.Data["plain"] != 1234.5 {
+		t.Errorf("Locale rule does not read numbers. Results: %v", results)
+	}
+
+	results = ValidateMap(map[string]interface{}{"foo": "1.5"}, ValidationRules{"foo": []string{"Float", "locale:de"}})
+	if results.IsValid || results.Errors["foo"][0] != "Float" {
+		t.Errorf("Locale rule should reject ambiguous numbers. Errors: %v", results.Errors)
+	}
+
+	results = ValidateMap(map[string]interface{}{"foo": "1"}, ValidationRules{"foo": []string{"Int", "locale:xx"}})
+	if results.IsValid {
+		t.Errorf("Unknown locales should fail.")
+	}
+}
+
+
+
+func TestLocaleOption(t *testing.T) {
+	data := map[string]interface{}{"a": "1.234,5", "b": "2.000", "c": "1'000.5"}
+	rules := ValidationRules{
+		"a": []string{"Float"},
+		"b": []string{"Int", "no_grouping"},
+		"c": []string{"Float", "locale:de-CH"},
+	}
+
+	results := ValidateMapWithOptions(data, rules, ValidationOptions{Locale: "de"})
+	if results.Data["a"] != 1234.5 || len(results.Errors["b"]) != 1 || results.Data["c"] != 1000.5 {
+		t.Errorf("Locale option does not read numbers. Results: %v", results)
+	}
+
+	results = ValidateMapWithOptions(data, rules, ValidationOptions{Locale: "de", RejectGrouping: true})
+	if len(results.Errors["a"]) != 1 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 {
+		t.Errorf("RejectGrouping should reject grouping separators. Results: %v", results)
+	}
+
+	results = ValidateMapWithOptions(map[string]interface{}{"a": "2,5"}, ValidationRules{"a": []string{"String"}},
+		ValidationOptions{Locale: "de"})
+	if results.Data["a"] != "2,5" {
+		t.Errorf("Locale option should not touch strings. Results: %v", results)
+	}
+}
@@ -0,0 +1,145 @@
+package validity
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Exact conversion of input values to numbers. Values are converted according to their kind, not by formatting them,
+// so large integers don't lose precision and floats from encoding/json aren't read as "1e+06":
+//
+//		Kind                 Int, as used by ParseInt                        Float, as used by ParseFloat
+//		int, int8...int64    Always                                          Always, rounding beyond 2^53
+//		uint, uint8...uint64 If no more than MaxInt64, otherwise "Overflow"  Always, rounding beyond 2^53
+//		uintptr              As for uints                                    As for uints
+//		float32, float64     If integral, otherwise "Fractional", and        Always
+//		                     "Overflow" beyond int64
+//		string, json.Number  Decimal notation with an optional exponent,     As strconv.ParseFloat
+//		                     like "42", "-7", "1e6" or "1.50e2", with
+//		                     "Fractional" and "Overflow" as for floats
+//		anything else        Formatted with %v, then as for strings          Formatted with %v, then as for strings
+//
+// NaN, and anything which isn't a number, fails with the type's own error, like "Int". ParseFloat converts NaN and
+// infinities, but then rejects them unless allowed.
+
+// Reasons a value couldn't be converted to an integer. Other than errNotNumber, the error text is what is reported.
+var (
+	errNotNumber  = errors.New("not a number")
+	errFractional = errors.New("Fractional")
+	errOverflow   = errors.New("Overflow")
+)
+
+var decimalPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// Integers with more digits than this overflow every Go integer type, so can be rejected before being expanded.
+const maxIntegerDigits = 20
+
+// Converts the value to an integer exactly, or returns why it couldn't be. See the table above.
+func toInteger(value interface{}) (*big.Int, error) {
+	val := reflect.ValueOf(value)
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return floatToInteger(val.Float())
+	case reflect.String:
+		return decimalToInteger(val.String())
+	case reflect.Invalid:
+		return nil, errNotNumber
+	}
+
+	return decimalToInteger(fmt.Sprintf("%v", value))
+}
+
+func floatToInteger(f float64) (*big.Int, error) {
+	switch {
+	case math.IsNaN(f):
+		return nil, errNotNumber
+	case math.IsInf(f, 0) || math.Abs(f) >= math.Ldexp(1, 64):
+		return nil, errOverflow
+	case math.Trunc(f) != f:
+		return nil, errFractional
+	}
+
+	out, _ := big.NewFloat(f).Int(nil)
+
+	return out, nil
+}
+
+// Converts decimal notation, like "-12", "1.5e3" or "100.00", to an integer. Rather than using big.Rat, which would
+// happily expand "1e1000000000", the digits are shifted by the exponent by hand, giving up as soon as the result is
+// too large for any Go integer.
+func decimalToInteger(s string) (*big.Int, error) {
+	match := decimalPattern.FindStringSubmatch(s)
+	if match == nil || match[2] == "" && match[3] == "" {
+		return nil, errNotNumber
+	}
+
+	sign, whole, fraction, exponent := match[1], match[2], match[3], match[4]
+
+	// The significant digits, and where the decimal point falls in them.
+	all      := whole + fraction
+	stripped := strings.TrimLeft(all, "0")
+	point    := len(whole) - (len(all) - len(stripped))
+	digits   := strings.TrimRight(stripped, "0")
+
+	if digits == "" {
+		return big.NewInt(0), nil
+	}
+
+	// Huge exponents are caught before they're added to the point, so that they can't wrap it around.
+	if exponent != "" {
+		shift, err := strconv.Atoi(exponent)
+		switch {
+		case err != nil && strings.HasPrefix(exponent, "-"):
+			return nil, errFractional
+		case err != nil:
+			return nil, errOverflow
+		case shift > maxIntegerDigits-point:
+			return nil, errOverflow
+		case shift < -len(all):
+			return nil, errFractional
+		}
+		point += shift
+	}
+
+	switch {
+	case point < len(digits):
+		return nil, errFractional
+	case point > maxIntegerDigits:
+		return nil, errOverflow
+	}
+
+	out, _ := new(big.Int).SetString(sign + digits + strings.Repeat("0", point-len(digits)), 10)
+
+	return out, nil
+}
+
+// Converts the value to a float. See the table above.
+func toFloat(value interface{}) (float64, error) {
+	val := reflect.ValueOf(value)
+
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(val.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(val.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return val.Float(), nil
+	case reflect.String:
+		return strconv.ParseFloat(val.String(), 64)
+	case reflect.Invalid:
+		return 0, errNotNumber
+	}
+
+	return strconv.ParseFloat(fmt.Sprintf("%v", value), 64)
+}
@@ -0,0 +1,127 @@
+package validity
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestToInteger(t *testing.T) {
+	type Priority uint8
+
+	pass := map[interface{}]int64{
+		42:                       42,
+		int8(-8):                 -8,
+		uint64(math.MaxInt64):    math.MaxInt64,
+		Priority(3):              3,
+		float64(1000000):         1000000,
+		float32(16777216):        16777216,
+		-0.0:                     0,
+		"42":                     42,
+		"+7":                     7,
+		"-007":                   -7,
+		"1e6":                    1000000,
+		"1.50e2":                 150,
+		"100.00":                 100,
+		"0.0":                    0,
+		"-9223372036854775808":   math.MinInt64,
+		json.Number("12345"):     12345,
+		json.Number("2.5E1"):     25,
+	}
+
+	for input, expected := range pass {
+		actual, err := toInteger(input)
+		if err != nil || !actual.IsInt64() || actual.Int64() != expected {
+			t.Errorf("%#v should convert to %d, got %v (%v).", input, expected, actual, err)
+		}
+	}
+
+	fail := map[interface{}]error{
+		1.5:                          errFractional,
+		"0.1":                        errFractional,
+		"1e-1":                       errFractional,
+		"1e-99999999999":             errFractional,
+		"0.001e-9223372036854775808": errFractional,
+		json.Number("1.25"):          errFractional,
+		math.Inf(1):                  errOverflow,
+		1e21:                         errOverflow,
+		"1e21":                       errOverflow,
+		"1e99999999999":              errOverflow,
+		"1e9223372036854775807":      errOverflow,
+		math.NaN():                   errNotNumber,
+		"":                           errNotNumber,
+		".":                          errNotNumber,
+		"1,000":                      errNotNumber,
+		"0x10":                       errNotNumber,
+		"one":                        errNotNumber,
+		true:                         errNotNumber,
+	}
+
+	for input, expected := range fail {
+		if _, err := toInteger(input); err != expected {
+			t.Errorf("%#v should fail with %v, got %v.", input, expected, err)
+		}
+	}
+
+	if actual, err := toInteger(uint64(math.MaxUint64)); err != nil || actual.IsInt64() || !actual.IsUint64() {
+		t.Errorf("MaxUint64 should convert exactly, got %v (%v).", actual, err)
+	}
+}
+
+
+
+func TestParseIntFromJSON(t *testing.T) {
+	data := map[string]interface{}{}
+	json.Unmarshal([]byte(`{"a": 1000000, "b": 1e21, "c": 2.5, "d": 9007199254740993}`), &data)
+
+	rules := ValidationRules{
+		"a": []string{"Int"},
+		"b": []string{"Int"},
+		"c": []string{"Int"},
+	}
+
+	results := ValidateMap(data, rules)
+	if results.Data["a"] != int64(1000000) {
+		t.Errorf("Integral floats should pass the Int type, got %v.", results.Errors["a"])
+	}
+	if len(results.Errors["b"]) != 1 || results.Errors["b"][0] != "Overflow" {
+		t.Errorf("Integers beyond int64 should fail with Overflow, got %v.", results.Errors["b"])
+	}
+	if len(results.Errors["c"]) != 1 || results.Errors["c"][0] != "Fractional" {
+		t.Errorf("Fractional values should fail with Fractional, got %v.", results.Errors["c"])
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(`{"d": 9007199254740993}`))
+	decoder.UseNumber()
+	decoder.Decode(&data)
+
+	results = ValidateMap(data, ValidationRules{"d": []string{"Int"}})
+	if results.Data["d"] != int64(9007199254740993) {
+		t.Errorf("json.Number should convert without losing precision, got %v.", results.Data["d"])
+	}
+}
+
+
+
+func TestToFloat(t *testing.T) {
+	pass := map[interface{}]float64{
+		42:                   42,
+		uint8(7):             7,
+		float32(0.5):         0.5,
+		"1.5":                1.5,
+		json.Number("2e3"):   2000,
+	}
+
+	for input, expected := range pass {
+		if actual, err := toFloat(input); err != nil || actual != expected {
+			t.Errorf("%#v should convert to %v, got %v (%v).", input, expected, actual, err)
+		}
+	}
+
+	for _, input := range []interface{}{"one", "", true, nil} {
+		if _, err := toFloat(input); err == nil {
+			t.Errorf("%#v should not convert to a float.", input)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package validity
+
+import (
+	"math"
+)
+
+// Rules about the shape of numbers, shared by the Int, Uint, Float and Decimal checkers. The multiple_of and step
+// rules compare exactly, using bound, so floats are taken by their shortest decimal form and 0.3 is a multiple of 0.1.
+
+// Returns the base for the step rule, which is the second argument if given, or zero.
+func stepBase(args []string) string {
+	if len(args) > 1 {
+		return args[1]
+	}
+
+	return "0"
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v IntValidityChecker) ValidateMultipleOf(step string) bool {
+	return v.bound().step(step, "0")
+}
+
+func (v IntValidityChecker) ValidateStep(args ...string) bool {
+	return len(args) > 0 && v.bound().step(args[0], stepBase(args))
+}
+
+func (v IntValidityChecker) ValidatePositive() bool {
+	return v.Item > 0
+}
+
+func (v IntValidityChecker) ValidateNegative() bool {
+	return v.Item < 0
+}
+
+func (v IntValidityChecker) ValidateNonNegative() bool {
+	return v.Item >= 0
+}
+
+func (v IntValidityChecker) ValidateInteger() bool {
+	return true
+}
+
+func (v IntValidityChecker) ValidateFinite() bool {
+	return true
+}
+
+func (v UintValidityChecker) ValidateMultipleOf(step string) bool {
+	return v.bound().step(step, "0")
+}
+
+func (v UintValidityChecker) ValidateStep(args ...string) bool {
+	return len(args) > 0 && v.bound().step(args[0], stepBase(args))
+}
+
+func (v UintValidityChecker) ValidatePositive() bool {
+	return v.Item > 0
+}
+
+func (v UintValidityChecker) ValidateNegative() bool {
+	return false
+}
+
+func (v UintValidityChecker) ValidateNonNegative() bool {
+	return true
+}
+
+func (v UintValidityChecker) ValidateInteger() bool {
+	return true
+}
+
+func (v UintValidityChecker) ValidateFinite() bool {
+	return true
+}
+
+func (v FloatValidityChecker) ValidateMultipleOf(step string) bool {
+	return v.bound().step(step, "0")
+}
+
+func (v FloatValidityChecker) ValidateStep(args ...string) bool {
+	return len(args) > 0 && v.bound().step(args[0], stepBase(args))
+}
+
+func (v FloatValidityChecker) ValidatePositive() bool {
+	return v.Item > 0
+}
+
+func (v FloatValidityChecker) ValidateNegative() bool {
+	return v.Item < 0
+}
+
+func (v FloatValidityChecker) ValidateNonNegative() bool {
+	return v.Item >= 0
+}
+
+// Passes if the value is a whole number, like 3.0.
+func (v FloatValidityChecker) ValidateInteger() bool {
+	return v.ValidateFinite() && math.Trunc(v.Item) == v.Item
+}
+
+// Passes if the value is neither NaN nor infinite. These are rejected anyway, unless allowed with "allow_nan" or
+// "allow_inf".
+func (v FloatValidityChecker) ValidateFinite() bool {
+	return !math.IsNaN(v.Item) && !math.IsInf(v.Item, 0)
+}
+
+// Passes if the value is a whole multiple of the given step, like "multiple_of:0.05".
+func (v DecimalValidityChecker) ValidateMultipleOf(step string) bool {
+	return v.bound().step(step, "0")
+}
+
+func (v DecimalValidityChecker) ValidateStep(args ...string) bool {
+	return len(args) > 0 && v.bound().step(args[0], stepBase(args))
+}
+
+func (v DecimalValidityChecker) ValidatePositive() bool {
+	return v.Item.Sign() > 0
+}
+
+func (v DecimalValidityChecker) ValidateNegative() bool {
+	return v.Item.Sign() < 0
+}
+
+func (v DecimalValidityChecker) ValidateNonNegative() bool {
+	return v.Item.Sign() >= 0
+}
+
+// Passes if the value is a whole number, like 3.00.
+func (v DecimalValidityChecker) ValidateInteger() bool {
+	return v.Item.IsInt()
+}
+
+func (v DecimalValidityChecker) ValidateFinite() bool {
+	return true
+}
@@ -0,0 +1,417 @@
+package validity
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNumericValidateMultipleOfPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "multiple_of:5"}}
+
+		for _, value := range []interface{}{0, 5, 100} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s multiple_of validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateMultipleOfFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "multiple_of:5"}}
+
+		for _, value := range []interface{}{1, 99} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s multiple_of validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateMultipleOfFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "multiple_of:0.1"}}
+
+		for _, value := range []interface{}{0.3, 1.7, -0.2, "2.5"} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s multiple_of validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateMultipleOfFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "multiple_of:0.1"}}
+
+		for _, value := range []interface{}{0.05, 1.75} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s multiple_of validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateMultipleOfZeroFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float"} {
+		rules := ValidationRules{"foo": []string{kind, "multiple_of:0"}}
+
+		for _, value := range []interface{}{0, 5} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s multiple_of validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+
+
+
+func TestNumericValidateStepPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "step:10,3"}}
+
+		for _, value := range []interface{}{3, 13, 103} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s step validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateStepFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "step:10,3"}}
+
+		for _, value := range []interface{}{0, 10, 14} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s step validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateStepFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "step:0.25,0.1"}}
+
+		for _, value := range []interface{}{0.1, 0.35, 1.1} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s step validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateStepFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "step:0.25,0.1"}}
+
+		for _, value := range []interface{}{0.25, 0.5} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s step validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateStepNoBasePass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Int", "step:2"}}
+
+	for _, value := range []interface{}{-4, 0, 2} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Int step validator does not pass %v.", value)
+		}
+	}
+}
+func TestNumericValidateStepNoBaseFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Int", "step:2"}}
+
+	for _, value := range []interface{}{-1, 3} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Int step validator does not fail %v.", value)
+		}
+	}
+}
+func TestNumericValidateStepNoSizeFail(t *testing.T) {
+	data := map[string]interface{}{"foo": 2}
+	rules := ValidationRules{"foo": []string{"Int", "step"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Int step validator does not fail.")
+	}
+}
+
+
+
+func TestNumericValidatePositivePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 1}
+		rules := ValidationRules{"foo": []string{kind, "positive"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s positive validator does not pass.", kind)
+		}
+	}
+}
+func TestNumericValidatePositiveFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "positive"}}
+
+		for _, value := range []interface{}{0, -1} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s positive validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidatePositiveFractionPass(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": 0.001}
+		rules := ValidationRules{"foo": []string{kind, "positive"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s positive validator does not pass.", kind)
+		}
+	}
+}
+func TestNumericValidatePositiveFractionFail(t *testing.T) {
+	for _, kind := range []string{"Float", "Decimal"} {
+		data := map[string]interface{}{"foo": -0.001}
+		rules := ValidationRules{"foo": []string{kind, "positive"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s positive validator does not fail.", kind)
+		}
+	}
+}
+func TestNumericValidatePositiveUintPass(t *testing.T) {
+	data := map[string]interface{}{"foo": 1}
+	rules := ValidationRules{"foo": []string{"Uint", "positive"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid {
+		t.Errorf("Uint positive validator does not pass.")
+	}
+}
+func TestNumericValidatePositiveUintFail(t *testing.T) {
+	data := map[string]interface{}{"foo": 0}
+	rules := ValidationRules{"foo": []string{"Uint", "positive"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Uint positive validator does not fail.")
+	}
+}
+
+
+
+func TestNumericValidateNegativePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": -1}
+		rules := ValidationRules{"foo": []string{kind, "negative"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s negative validator does not pass.", kind)
+		}
+	}
+}
+func TestNumericValidateNegativeFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "negative"}}
+
+		for _, value := range []interface{}{0, 1} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if results.IsValid {
+				t.Errorf("%s negative validator does not fail %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateNegativeUintFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Uint", "negative"}}
+
+	for _, value := range []interface{}{0, 1} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Uint negative validator does not fail %v.", value)
+		}
+	}
+}
+
+
+
+func TestNumericValidateNonNegativePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		rules := ValidationRules{"foo": []string{kind, "non_negative"}}
+
+		for _, value := range []interface{}{0, 1} {
+			results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+			if !results.IsValid {
+				t.Errorf("%s non_negative validator does not pass %v.", kind, value)
+			}
+		}
+	}
+}
+func TestNumericValidateNonNegativeFail(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Float", "Decimal"} {
+		data := map[string]interface{}{"foo": -1}
+		rules := ValidationRules{"foo": []string{kind, "non_negative"}}
+
+		results := ValidateMap(data, rules)
+		if results.IsValid {
+			t.Errorf("%s non_negative validator does not fail.", kind)
+		}
+	}
+}
+
+
+
+func TestFloatValidateIntegerPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Float", "integer"}}
+
+	for _, value := range []interface{}{0, 3.0, -7, 1e20} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Float integer validator does not pass %v.", value)
+		}
+	}
+}
+func TestFloatValidateIntegerFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Float", "integer"}}
+
+	for _, value := range []interface{}{0.5, -1.25} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Float integer validator does not fail %v.", value)
+		}
+	}
+}
+func TestFloatValidateIntegerInfFail(t *testing.T) {
+	data := map[string]interface{}{"foo": math.Inf(1)}
+	rules := ValidationRules{"foo": []string{"Float", "allow_inf", "integer"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Float integer validator does not fail.")
+	}
+}
+func TestDecimalValidateIntegerPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "integer"}}
+
+	for _, value := range []interface{}{0, "3.00", -7, "1e20"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Decimal integer validator does not pass %v.", value)
+		}
+	}
+}
+func TestDecimalValidateIntegerFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Decimal", "integer"}}
+
+	for _, value := range []interface{}{"0.5", -1.25, "1e-20"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Decimal integer validator does not fail %v.", value)
+		}
+	}
+}
+func TestNumericValidateIntegerPass(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Uint8"} {
+		data := map[string]interface{}{"foo": 3}
+		rules := ValidationRules{"foo": []string{kind, "integer"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s integer validator does not pass.", kind)
+		}
+	}
+}
+
+
+
+func TestFloatRejectsNaNAndInf(t *testing.T) {
+	for _, value := range []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), "NaN", "-Inf"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, ValidationRules{"foo": []string{"Float"}})
+		if results.IsValid || results.Errors["foo"][0] != "Finite" {
+			t.Errorf("Float should reject %v with Finite. Errors: %v", value, results.Errors)
+		}
+	}
+}
+func TestFloatAllowNanPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Float", "allow_nan"}}
+
+	for _, value := range []interface{}{math.NaN(), "nan"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Float allow_nan validator does not pass %v.", value)
+		}
+	}
+}
+func TestFloatAllowNanFail(t *testing.T) {
+	data := map[string]interface{}{"foo": math.Inf(1)}
+	rules := ValidationRules{"foo": []string{"Float", "allow_nan"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Float allow_nan validator does not fail.")
+	}
+}
+func TestFloatAllowInfPass(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Float", "allow_inf"}}
+
+	for _, value := range []interface{}{math.Inf(1), "-inf"} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if !results.IsValid {
+			t.Errorf("Float allow_inf validator does not pass %v.", value)
+		}
+	}
+}
+func TestFloatAllowInfFail(t *testing.T) {
+	data := map[string]interface{}{"foo": math.NaN()}
+	rules := ValidationRules{"foo": []string{"Float", "allow_inf"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("Float allow_inf validator does not fail.")
+	}
+}
+func TestFloatValidateFinitePass(t *testing.T) {
+	data := map[string]interface{}{"foo": 1.5}
+	rules := ValidationRules{"foo": []string{"Float", "allow_nan", "allow_inf", "finite"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid {
+		t.Errorf("Float finite validator does not pass.")
+	}
+}
+func TestFloatValidateFiniteFail(t *testing.T) {
+	rules := ValidationRules{"foo": []string{"Float", "allow_nan", "allow_inf", "finite"}}
+
+	for _, value := range []interface{}{math.NaN(), math.Inf(-1)} {
+		results := ValidateMap(map[string]interface{}{"foo": value}, rules)
+		if results.IsValid {
+			t.Errorf("Float finite validator does not fail %v.", value)
+		}
+	}
+}
+func TestNumericValidateFinitePass(t *testing.T) {
+	for _, kind := range []string{"Int", "Uint", "Decimal"} {
+		data := map[string]interface{}{"foo": 3}
+		rules := ValidationRules{"foo": []string{kind, "finite"}}
+
+		results := ValidateMap(data, rules)
+		if !results.IsValid {
+			t.Errorf("%s finite validator does not pass.", kind)
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package validity
+
+import (
+	"bufio"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Password rules on the StringValidityChecker. The "password" rule is a sensible default, and the others can be used
+// to tighten or replace it.
+
+// Runs of characters which count as sequences, like "abcd" or "qwer". They are checked forwards and backwards.
+var passwordSequences = []string{"abcdefghijklmnopqrstuvwxyz", "0123456789", "1234567890", "qwertyuiop", "asdfghjkl",
+	"zxcvbnm"}
+
+// The blocklist from LoadPasswordBlocklist. Passwords are stored as sorted 64 bit hashes of their lowercased form, so
+// lookups are a binary search and a million passwords take 8MB.
+var (
+	passwordBlocklist     []uint64
+	passwordBlocklistLock sync.RWMutex
+)
+
+func hashPassword(password string) uint64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(strings.ToLower(password)))
+
+	return hash.Sum64()
+}
+
+// Loads a list of common or breached passwords from a file, one per line, to be used by the "password" and
+// "not_blocklisted" rules. Passwords are compared without regard to case. The list replaces any loaded before.
+func LoadPasswordBlocklist(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hashes  := []uint64{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		if line := strings.TrimRight(scanner.Text(), "\r"); line != "" {
+			hashes = append(hashes, hashPassword(line))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+
+	passwordBlocklistLock.Lock()
+	passwordBlocklist = hashes
+	passwordBlocklistLock.Unlock()
+
+	return nil
+}
+
+func isBlocklisted(password string) bool {
+	passwordBlocklistLock.RLock()
+	defer passwordBlocklistLock.RUnlock()
+
+	hash := hashPassword(password)
+	i    := sort.Search(len(passwordBlocklist), func(i int) bool { return passwordBlocklist[i] >= hash })
+
+	return i < len(passwordBlocklist) && passwordBlocklist[i] == hash
+}
+
+// Returns the character classes in the password: lower, upper, digit and symbol.
+func passwordClasses(password string) map[string]bool {
+	classes := map[string]bool{}
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			classes["lower"] = true
+		case unicode.IsUpper(r):
+			classes["upper"] = true
+		case unicode.IsDigit(r):
+			classes["digit"] = true
+		default:
+			classes["symbol"] = true
+		}
+	}
+
+	return classes
+}
+
+// Returns whether the runes at i and i+1 continue a sequence, in either direction.
+func continuesSequence(runes []rune, i int) bool {
+	pair := string(runes[i:i+2])
+
+	for _, sequence := range passwordSequences {
+		if strings.Contains(sequence, pair) || strings.Contains(reverseString(sequence), pair) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return string(runes)
+}
+
+// Estimates the entropy of the password in bits. Each character is worth log2 of the size of the character classes
+// used, except characters which repeat the one before or continue a sequence, which are worth a single bit. This is a
+// rough estimate, which errs on the side of being too generous to passwords made of dictionary words.
+func passwordEntropy(password string) float64 {
+	pool    := 0
+	classes := passwordClasses(password)
+	sizes   := map[string]int{"lower": 26, "upper": 26, "digit": 10, "symbol": 33}
+
+	for class := range classes {
+		pool += sizes[class]
+	}
+	if pool == 0 {
+		return 0
+	}
+
+	runes   := []rune(strings.ToLower(password))
+	perRune := math.Log2(float64(pool))
+	bits    := 0.0
+
+	for i := range runes {
+		if i > 0 && (runes[i] == runes[i-1] || continuesSequence(runes, i-1)) {
+			bits++
+		} else {
+			bits += perRune
+		}
+	}
+
+	return bits
+}
+
+// Scores the password from 0 (terrible) to 4 (strong), from its entropy. Blocklisted passwords always score 0.
+func passwordScore(password string) int {
+	if isBlocklisted(password) {
+		return 0
+	}
+
+	bits := passwordEntropy(password)
+	switch {
+	case bits < 25:
+		return 0
+	case bits < 40:
+		return 1
+	case bits < 60:
+		return 2
+	case bits < 80:
+		return 3
+	}
+
+	return 4
+}
+
+// Converts the rule argument to an integer, using the default if it wasn't given.
+func intArgument(args []string, i int, def int) int {
+	if len(args) <= i {
+		return def
+	}
+
+	out, err := strconv.Atoi(args[i])
+	if err != nil {
+		return def
+	}
+
+	return out
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes for passwords at least min characters long (8 by default) which score at least score (3 by default) out of
+// 4, and are not blocklisted. For example, "password:12,3".
+func (v StringValidityChecker) ValidatePassword(args ...string) bool {
+	return len([]rune(v.Item)) >= intArgument(args, 0, 8) && passwordScore(v.Item) >= intArgument(args, 1, 3)
+}
+
+// Passes if the password has every one of the given classes, like "password_classes:lower,upper,digit,symbol", or if
+// given a number, at least that many of the four classes, like "password_classes:3".
+func (v StringValidityChecker) ValidatePasswordClasses(classes ...string) bool {
+	found := passwordClasses(v.Item)
+
+	if len(classes) == 1 {
+		if count, err := strconv.Atoi(classes[0]); err == nil {
+			return len(found) >= count
+		}
+	}
+
+	for _, class := range classes {
+		if !found[strings.ToLower(class)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Passes if the password's estimated entropy is at least the given number of bits.
+func (v StringValidityChecker) ValidatePasswordEntropy(bits string) bool {
+	min, err := strconv.ParseFloat(bits, 64)
+
+	return err == nil && passwordEntropy(v.Item) >= min
+}
+
+// Passes if no character is repeated the given number of times in a row (3 by default), like "aaa".
+func (v StringValidityChecker) ValidateNoRepeats(args ...string) bool {
+	max   := intArgument(args, 0, 3)
+	runes := []rune(v.Item)
+	run   := 1
+
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == runes[i-1] {
+			run++
+		} else {
+			run = 1
+		}
+		if run >= max {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Passes if there is no sequence of the given length (4 by default), like "abcd", "4321" or "qwer".
+func (v StringValidityChecker) ValidateNoSequences(args ...string) bool {
+	max   := intArgument(args, 0, 4)
+	runes := []rune(strings.ToLower(v.Item))
+	run   := 1
+
+	for i := 1; i < len(runes); i++ {
+		if continuesSequence(runes, i-1) {
+			run++
+		} else {
+			run = 1
+		}
+		if run >= max {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Passes if the value doesn't contain the values of any of the given fields, like "not_containing:username,email".
+// Comparison ignores case. For email addresses, the part before the @ is checked too. Values shorter than three
+// characters are ignored, as they'd reject too much.
+func (v StringValidityChecker) ValidateNotContaining(fields ...string) bool {
+	item := strings.ToLower(v.Item)
+
+	for _, field := range fields {
+		other, exists := lookupPath(v.Input, field)
+		if !exists {
+			continue
+		}
+
+		value  := strings.ToLower(fmt.Sprintf("%v", other))
+		checks := []string{value}
+		if at := strings.LastIndex(value, "@"); at > 0 {
+			checks = append(checks, value[:at])
+		}
+
+		for _, check := range checks {
+			if len([]rune(check)) >= 3 && strings.Contains(item, check) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Passes if the value is not in the list loaded with LoadPasswordBlocklist.
+func (v StringValidityChecker) ValidateNotBlocklisted() bool {
+	return !isBlocklisted(v.Item)
+}
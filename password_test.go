@@ -0,0 +1,265 @@
+package validity
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStringValidatePasswordPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password"}}
+
+	for _, value := range []string{"correct horse battery staple", "Tr0ub4dor&3x!", "x7#Kp2!mQz9"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String password validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidatePasswordFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password"}}
+
+	for _, value := range []string{"short", "aaaaaaaaaaaa", "abcdefghijkl", "password"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String password validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePasswordLengthsPass(t *testing.T) {
+	data := TestStruct{Foo: "correct horse battery staple!"}
+	rules := ValidationRules{"Foo": []string{"String", "password:16,4"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String password validator does not pass.")
+	}
+}
+func TestStringValidatePasswordLengthsFail(t *testing.T) {
+	data := TestStruct{Foo: "Tr0ub4dor&3x!"}
+	rules := ValidationRules{"Foo": []string{"String", "password:16,4"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String password validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidatePasswordClassesPass(t *testing.T) {
+	data := TestStruct{Foo: "aB3!"}
+	rules := ValidationRules{"Foo": []string{"String", "password_classes:lower,upper,digit,symbol"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String password_classes validator does not pass.")
+	}
+}
+func TestStringValidatePasswordClassesFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password_classes:lower,upper,digit,symbol"}}
+
+	for _, value := range []string{"aB3", "ab3!", "AB3!"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String password_classes validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePasswordClassesCountPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password_classes:3"}}
+
+	for _, value := range []string{"aB3", "ab3!"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String password_classes validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidatePasswordClassesCountFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password_classes:3"}}
+
+	for _, value := range []string{"ab3", "abc"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String password_classes validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidatePasswordEntropyPass(t *testing.T) {
+	data := TestStruct{Foo: "x7#Kp2!mQz9"}
+	rules := ValidationRules{"Foo": []string{"String", "password_entropy:50"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String password_entropy validator does not pass.")
+	}
+}
+func TestStringValidatePasswordEntropyFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "password_entropy:50"}}
+
+	for _, value := range []string{"aaaaaaaaaaaaaaaaaaaa", "abcdefghijklmnopqrst", "hello"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String password_entropy validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateNoRepeatsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_repeats"}}
+
+	for _, value := range []string{"aab", "abab"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String no_repeats validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNoRepeatsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_repeats"}}
+
+	for _, value := range []string{"aaab", "baaa"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String no_repeats validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateNoRepeatsLimitPass(t *testing.T) {
+	data := TestStruct{Foo: "aaaab"}
+	rules := ValidationRules{"Foo": []string{"String", "no_repeats:5"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String no_repeats validator does not pass.")
+	}
+}
+func TestStringValidateNoRepeatsLimitFail(t *testing.T) {
+	data := TestStruct{Foo: "aaaaab"}
+	rules := ValidationRules{"Foo": []string{"String", "no_repeats:5"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String no_repeats validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidateNoSequencesPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_sequences"}}
+
+	for _, value := range []string{"abc!", "ab12"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String no_sequences validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNoSequencesFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_sequences"}}
+
+	for _, value := range []string{"xabcd", "9876", "QWER", "zxcv"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String no_sequences validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateNoSequencesLengthPass(t *testing.T) {
+	data := TestStruct{Foo: "ab!12"}
+	rules := ValidationRules{"Foo": []string{"String", "no_sequences:3"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String no_sequences validator does not pass.")
+	}
+}
+func TestStringValidateNoSequencesLengthFail(t *testing.T) {
+	data := TestStruct{Foo: "xabc"}
+	rules := ValidationRules{"Foo": []string{"String", "no_sequences:3"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String no_sequences validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidateNotContaining(t *testing.T) {
+	data := map[string]interface{}{
+		"username": "Connor",
+		"email":    "peet@example.com",
+		"a":        "my-secret-pass",
+		"b":        "iamconnor123",
+		"c":        "peet-rocks",
+	}
+	rule := "not_containing:username,email,missing"
+	rules := ValidationRules{"a": []string{"String", rule}, "b": []string{"String", rule}, "c": []string{"String", rule}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 1 {
+		t.Errorf("String not_containing validator does not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+// Loads a small blocklist for the test, and clears it again once the test is done.
+func loadTestBlocklist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "passwords.txt")
+	os.WriteFile(path, []byte("123456\npassword\nCorrect Horse Battery Staple\n"), 0644)
+
+	if err := LoadPasswordBlocklist(path); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { passwordBlocklist = nil })
+}
+
+func TestStringValidateNotBlocklistedPass(t *testing.T) {
+	loadTestBlocklist(t)
+	rules := ValidationRules{"Foo": []string{"String", "not_blocklisted"}}
+
+	for _, value := range []string{"something else", "1234567"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String not_blocklisted validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNotBlocklistedFail(t *testing.T) {
+	loadTestBlocklist(t)
+	rules := ValidationRules{"Foo": []string{"String", "not_blocklisted"}}
+
+	for _, value := range []string{"123456", "PASSWORD", "correct horse battery staple"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String not_blocklisted validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePasswordBlocklistedFail(t *testing.T) {
+	loadTestBlocklist(t)
+	data := TestStruct{Foo: "correct horse battery staple"}
+	rules := ValidationRules{"Foo": []string{"String", "password"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String password validator does not fail on blocklisted passwords.")
+	}
+}
+
+
+
+func TestLoadPasswordBlocklistMissingFile(t *testing.T) {
+	if err := LoadPasswordBlocklist("/does/not/exist"); err == nil {
+		t.Errorf("Loading a missing password blocklist should fail.")
+	}
+}
@@ -0,0 +1,191 @@
+package validity
+
+import (
+	_ "embed"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type PhoneValidityChecker struct {
+	Key   string
+	Rules []string
+	// Item is the number in E.164 format, like "+442071838750".
+	Item  string
+	// The country calling code, like "44", and the national significant number after it.
+	CallingCode string
+	National    string
+}
+
+// A numbering plan for a region, from data/phone.txt.
+type phonePlan struct {
+	region      string
+	callingCode string
+	trunk       string
+	min         int
+	max         int
+}
+
+//go:embed data/phone.txt
+var phoneData string
+
+// An international number with its trunk prefix in parentheses after the calling code, like "+44(0)20".
+var phoneTrunkInParens = regexp.MustCompile(`^(\+|00)(\d{1,3})\(0\)`)
+
+var (
+	phoneOnce          sync.Once
+	phonePlansByRegion map[string]phonePlan
+	phonePlansByCode   map[string][]phonePlan
+)
+
+// Parses data/phone.txt into its lookup tables.
+func loadPhonePlans() {
+	phonePlansByRegion = map[string]phonePlan{}
+	phonePlansByCode   = map[string][]phonePlan{}
+
+	for _, fields := range referenceLines(phoneData) {
+		plan := phonePlan{region: fields[0], callingCode: fields[1], trunk: fields[2]}
+		plan.min, _ = strconv.Atoi(fields[3])
+		plan.max, _ = strconv.Atoi(fields[4])
+		if plan.trunk == "-" {
+			plan.trunk = ""
+		}
+
+		phonePlansByRegion[plan.region] = plan
+		phonePlansByCode[plan.callingCode] = append(phonePlansByCode[plan.callingCode], plan)
+	}
+}
+
+// Removes a "(0)" trunk prefix written after the calling code, like in "+44 (0)20 7183 8750", which isn't dialled from
+// abroad. It's only removed for calling codes where 0 is the trunk prefix, as in Italy the 0 is part of the number.
+func dropPhoneTrunk(s string) string {
+	compact := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(" \t-.", r) {
+			return -1
+		}
+		return r
+	}, s)
+
+	match := phoneTrunkInParens.FindStringSubmatch(compact)
+	if match == nil {
+		return s
+	}
+
+	for _, plan := range phonePlansByCode[match[2]] {
+		if plan.trunk == "0" {
+			return match[1] + match[2] + compact[len(match[0]):]
+		}
+	}
+
+	return s
+}
+
+// Normalises a phone number to E.164, returning the calling code and national significant number. Spaces, hyphens,
+// dots and parentheses are removed, a "(0)" trunk prefix after the calling code is dropped, and a leading "00" is
+// treated as "+". Numbers without a "+" or "00" are national numbers in the region given, which may be "" to only allow
+// international numbers; their trunk prefix (like the leading 0 in the UK) is removed. The calling code must be known,
+// and the national number must have a length used by one of the regions with that code.
+func parsePhone(s string, region string) (callingCode string, national string, ok bool) {
+	phoneOnce.Do(loadPhonePlans)
+
+	digits := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(" \t-.()", r) {
+			return -1
+		}
+		return r
+	}, dropPhoneTrunk(strings.TrimSpace(s)))
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	default:
+		plan, exists := phonePlansByRegion[strings.ToUpper(region)]
+		if !exists {
+			return "", "", false
+		}
+		digits = plan.callingCode + strings.TrimPrefix(digits, plan.trunk)
+	}
+
+	if !isDigits(digits) || len(digits) > 15 {
+		return "", "", false
+	}
+
+	// Calling codes are prefix free, so at most one of these can match.
+	for length := 1; length <= 3 && length < len(digits); length++ {
+		plans, exists := phonePlansByCode[digits[:length]]
+		if !exists {
+			continue
+		}
+
+		national = digits[length:]
+		for _, plan := range plans {
+			if len(national) >= plan.min && len(national) <= plan.max {
+				return digits[:length], national, true
+			}
+		}
+
+		return "", "", false
+	}
+
+	return "", "", false
+}
+
+// Returns the regions which use the calling code, like []string{"GB", "GG", "JE", "IM"} for "44".
+func phoneRegions(callingCode string) []string {
+	phoneOnce.Do(loadPhonePlans)
+
+	regions := []string{}
+	for _, plan := range phonePlansByCode[callingCode] {
+		regions = append(regions, plan.region)
+	}
+
+	return regions
+}
+
+func (v PhoneValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v PhoneValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v PhoneValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v PhoneValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes if the number's calling code is one of those given, like "calling_code:44,353".
+func (v PhoneValidityChecker) ValidateCallingCode(codes ...string) bool {
+	for _, code := range codes {
+		if strings.TrimPrefix(code, "+") == v.CallingCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the number's calling code is used by any of the given regions, like "phone_region:GB,IE". Note that some
+// regions share calling codes, so "phone_region:US" also allows Canadian numbers.
+func (v PhoneValidityChecker) ValidatePhoneRegion(regions ...string) bool {
+	for _, region := range phoneRegions(v.CallingCode) {
+		for _, allowed := range regions {
+			if strings.EqualFold(region, allowed) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestPhoneNormalises(t *testing.T) {
+	data := map[string]interface{}{
+		"a": "+44 20 7183 8750",
+		"b": "0044 (20) 7183-8750",
+		"c": "+1 (415) 555-2671",
+		"d": "+39 06 1234 5678",
+		"e": "+44 (0)20 7183 8750",
+		"f": "0049 (0) 30 1234567",
+		"g": "+39 (0)6 1234 5678",
+	}
+	rules := ValidationRules{}
+	for key := range data {
+		rules[key] = []string{"Phone"}
+	}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid ||
+		results.Data["a"] != "+442071838750" ||
+		results.Data["b"] != "+442071838750" ||
+		results.Data["c"] != "+14155552671" ||
+		results.Data["d"] != "+390612345678" ||
+		results.Data["e"] != "+442071838750" ||
+		results.Data["f"] != "+49301234567" ||
+		results.Data["g"] != "+390612345678" {
+		t.Errorf("Phone type does not normalise numbers. Results: %v", results)
+	}
+}
+func TestPhoneRejectsInvalid(t *testing.T) {
+	data := map[string]interface{}{
+		"national": "020 7183 8750",
+		"short":    "+44 20 718",
+		"long":     "+1 415 555 26711",
+		"code":     "+999 1234 5678",
+		"letters":  "+44 20 CALL NOW",
+		"ext":      "+44 20 7183 8750 x12",
+	}
+	rules := ValidationRules{}
+	for key := range data {
+		rules[key] = []string{"Phone"}
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors) != len(data) || results.Errors["national"][0] != "Phone" {
+		t.Errorf("Phone type does not reject invalid numbers. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestPhoneDefaultRegion(t *testing.T) {
+	data := map[string]interface{}{"gb": "020 7183 8750", "us": "(415) 555-2671", "it": "06 1234 5678", "intl": "+33 1 23 45 67 89"}
+	rules := ValidationRules{
+		"gb":   []string{"Phone", "default_region:GB"},
+		"us":   []string{"Phone", "default_region:US"},
+		"it":   []string{"Phone", "default_region:IT"},
+		"intl": []string{"Phone", "default_region:GB"},
+	}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid ||
+		results.Data["gb"] != "+442071838750" ||
+		results.Data["us"] != "+14155552671" ||
+		results.Data["it"] != "+390612345678" ||
+		results.Data["intl"] != "+33123456789" {
+		t.Errorf("Phone type does not use the default region. Results: %v", results)
+	}
+}
+
+
+
+func TestPhoneValidateCallingCode(t *testing.T) {
+	data := map[string]interface{}{"a": "+44 20 7183 8750", "b": "+33 1 23 45 67 89"}
+	rules := ValidationRules{"a": []string{"Phone", "calling_code:44,+353"}, "b": []string{"Phone", "calling_code:44,+353"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Phone calling_code validator does not work. Errors: %v", results.Errors)
+	}
+}
+func TestPhoneValidatePhoneRegion(t *testing.T) {
+	data := map[string]interface{}{"a": "+1 416 555 0123", "b": "+44 20 7183 8750"}
+	rules := ValidationRules{"a": []string{"Phone", "phone_region:ca"}, "b": []string{"Phone", "phone_region:US,CA"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 1 {
+		t.Errorf("Phone phone_region validator does not work. Errors: %v", results.Errors)
+	}
+}
@@ -0,0 +1,63 @@
+package validity
+
+import (
+	_ "embed"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed data/postal_codes.txt
+var postalCodesData string
+
+var (
+	postalCodesOnce sync.Once
+	postalCodes     map[string]*regexp.Regexp
+)
+
+// Parses data/postal_codes.txt, compiling each pattern to match the whole string without regard to case.
+func loadPostalCodes() {
+	postalCodes = map[string]*regexp.Regexp{}
+
+	for _, line := range strings.Split(postalCodesData, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), " ", 2)
+		if len(parts) != 2 || strings.HasPrefix(parts[0], "#") {
+			continue
+		}
+
+		postalCodes[parts[0]] = regexp.MustCompile("(?i)^(?:" + parts[1] + ")$")
+	}
+}
+
+// Returns whether the postal code is valid in the country. Unknown countries, including those without postal codes,
+// are never valid.
+func isPostalCode(code string, country string) bool {
+	postalCodesOnce.Do(loadPostalCodes)
+
+	pattern, exists := postalCodes[strings.ToUpper(strings.TrimSpace(country))]
+	return exists && pattern.MatchString(code)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes if the value is a postal code in any of the given countries, like "postal_code:GB" or "postal_code:US,CA".
+func (v StringValidityChecker) ValidatePostalCode(countries ...string) bool {
+	for _, country := range countries {
+		if isPostalCode(v.Item, country) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the value is a postal code in the country given by another field of the input, like
+// "postal_code_for:country" or "postal_code_for:address.country". Fails if that field is missing.
+func (v StringValidityChecker) ValidatePostalCodeFor(field string) bool {
+	country, exists := lookupPath(v.Input, field)
+
+	return exists && isPostalCode(v.Item, fmt.Sprintf("%v", country))
+}
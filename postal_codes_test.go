@@ -0,0 +1,105 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestStringValidatePostalCodePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:GB"}}
+
+	for _, value := range []string{"SW1A 1AA", "sw1a1aa", "M1 1AE", "GIR 0AA"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String postal_code validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:GB"}}
+
+	for _, value := range []string{"12345", "SW1A 1A", ""} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String postal_code validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeCountriesPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:US,CA"}}
+
+	for _, value := range []string{"90210", "90210-1234", "K1A 0B1"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String postal_code validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeCountriesFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:US,CA"}}
+
+	for _, value := range []string{"9021", "D1A 0B1", "SW1A 1AA"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String postal_code validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeLettersPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:NL"}}
+
+	for _, value := range []string{"1012 AB", "1012AB"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String postal_code validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeLettersFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:NL"}}
+
+	for _, value := range []string{"1012", "AB 1012"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String postal_code validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidatePostalCodeUnknownCountryFail(t *testing.T) {
+	data := TestStruct{Foo: "12345"}
+	rules := ValidationRules{"Foo": []string{"String", "postal_code:XX"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String postal_code validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidatePostalCodeFor(t *testing.T) {
+	data := map[string]interface{}{
+		"country": "DE",
+		"zip":     "10115",
+		"bad":     "SW1A 1AA",
+		"address": map[string]interface{}{"country": "gb", "postcode": "SW1A 1AA"},
+	}
+	rules := ValidationRules{
+		"zip":              []string{"String", "postal_code_for:country"},
+		"bad":              []string{"String", "postal_code_for:country"},
+		"address.postcode": []string{"String", "postal_code_for:address.country"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["zip"]) != 0 || len(results.Errors["bad"]) != 1 || len(results.Errors["address.postcode"]) != 0 {
+		t.Errorf("String postal_code_for validator does not use the country field. Errors: %v", results.Errors)
+	}
+}
+func TestStringValidatePostalCodeForMissingField(t *testing.T) {
+	data := map[string]interface{}{"zip": "10115"}
+	rules := ValidationRules{"zip": []string{"String", "postal_code_for:country"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid {
+		t.Errorf("String postal_code_for validator does not fail when the country is missing.")
+	}
+}
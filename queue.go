@@ -2,7 +2,9 @@ package validity
 
 import (
 	"fmt"
-	"strconv"
+	"math"
+	"net/url"
+	"strings"
 )
 
 // This queue object is where we're going to store all our data related to validation of a particular set.
@@ -16,6 +18,8 @@ type ValidityQueue struct {
 	Data     map[string]interface{}
 	Rules    ValidationRules
 	Results  *ValidationResults
+	// Options tweak how the queue treats its input. The zero value gives the default behaviour.
+	Options  ValidationOptions
 }
 
 // ValidityParsers is a map of functions to parse the given types with. Each one is responsible for converting the value
@@ -26,6 +30,24 @@ type ValidityQueue struct {
 // Apologies if this is not The Go Way™, put in a PR if you have a better solution :)
 type ValidityParsers struct{}
 
+// Rules which are handled by the queue or by the parsers, or which only change how other rules behave, rather than
+// being validators themselves. They are given in StudlyCase, as returned from parseRule. Filters are also queue rules,
+// but are found by looking at ValidityFilters instead.
+var queueRules = map[string]bool{
+	"Required":      true,
+	"Default":       true,
+	"DefaultRegion": true,
+	"AllowNan":      true,
+	"AllowInf":      true,
+	"Locale":        true,
+	"NoGrouping":    true,
+}
+
+// Returns whether the StudlyCased rule is handled outside of the checkers.
+func isQueueRule(method string) bool {
+	return queueRules[method] || isFilter(method)
+}
+
 // Run is reponsible for resetting the results, then running parsers/checkers. The actual validation occurs in two
 // stages. First, the data is run through to have its types fixed and appropriate checkers added to the queue. Inability
 // to convert types will put an error in the output, and no checker will be added for the second stage.
@@ -40,17 +62,43 @@ func (c ValidityQueue) Run() {
 
 	c.RunParsers()
 	c.RunCheckers()
+	c.RunUnknown()
 }
 
 // Runs the parsers, for the second stage. See Run() for explaination.
 func (c *ValidityQueue) RunParsers() {
 	for key, validator := range c.Rules {
-		item, exists := c.Data[key]
+		item, exists := lookupPath(c.Data, key)
 
 		if !exists {
 			if inSlice("required", validator) {
 				c.AddError(key, "required")
+				continue
+			}
+
+			// Absent fields with a default get the default value instead, which is then parsed and checked like any
+			// other value so that it ends up in the results with the right type.
+			item, exists = findRuleArgument("default", validator[1:])
+			if !exists {
+				continue
 			}
+		} else if c.Options.Strict {
+			// Strict mode checks the value as it was given. Defaults are written as strings in the rules, so they're
+			// exempt, as are numbers written for a locale.
+			if mismatch := strictMismatch(validator[0], item); mismatch != "" && !c.isLocalizedNumber(item, validator) {
+				c.AddError(key, "TypeMismatch:" + mismatch)
+				continue
+			}
+		}
+
+		// Filters run first, in order, so the parser and checker only ever see the filtered value.
+		item = applyFilters(item, validator[1:])
+
+		// Numbers written for a locale are rewritten into Go syntax. If that fails, the value can't be trusted to
+		// mean the same thing in Go syntax, so it fails outright.
+		item, ok := c.localizeNumber(item, validator)
+		if !ok {
+			c.AddError(key, validator[0])
 			continue
 		}
 
@@ -74,6 +122,52 @@ func (c *ValidityQueue) RunCheckers() {
 	}
 }
 
+// Looks for keys in the data which have no rules, and reports or copies them depending on Options.UnknownKeys. This
+// runs after the checkers, so passed through values never overwrite anything which was actually validated.
+func (c *ValidityQueue) RunUnknown() {
+	if c.Options.UnknownKeys == IgnoreUnknown {
+		return
+	}
+
+	c.runUnknownIn("", c.Data)
+}
+
+// Walks through a single level of the data. Nested maps are only descended into if there are rules which point
+// inside of them, like "address.city". Otherwise the whole map is treated as one value.
+func (c *ValidityQueue) runUnknownIn(prefix string, data map[string]interface{}) {
+	for key, item := range data {
+		path := prefix + key
+		_, hasRule := c.Rules[path]
+
+		if nested, ok := item.(map[string]interface{}); ok && c.hasNestedRules(path) {
+			c.runUnknownIn(path + ".", nested)
+			continue
+		}
+
+		if hasRule {
+			continue
+		}
+
+		switch c.Options.UnknownKeys {
+		case RejectUnknown:
+			c.AddError(path, "Unknown")
+		case PassthroughUnknown:
+			c.Results.Data[path] = item
+		}
+	}
+}
+
+// Returns whether any rule is for a key nested inside of the given path.
+func (c *ValidityQueue) hasNestedRules(path string) bool {
+	for key := range c.Rules {
+		if strings.HasPrefix(key, path + ".") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Calling AddError inserts an error into the Results.Error, with the specified key. If there are already more than
 // zero errors for the key, then the error is simply appended on the list.
 func (c *ValidityQueue) AddError(key string, error string) {
@@ -93,34 +187,150 @@ func (c *ValidityQueue) AddErrors(key string, errors []string) {
 	}
 }
 
-// Converts the given value to an integer. Parses it as a string. Not super amazing, but the most elegant solution that
-// I was able to find. Before we used reflection of original type with switch statements... *shudders*
+// Converts the given value to an integer. This used to format the value and parse it as a string, but that read the
+// float64s from encoding/json as "1e+06", so now values are converted exactly according to their kind. Fractional
+// values fail with "Fractional", and values beyond int64 with "Overflow". See toInteger for the full table.
 func (v ValidityParsers) ParseInt(c *ValidityQueue, key string, value interface{}, rules []string) {
-	item := fmt.Sprintf("%v", value)
-
-	val, err := strconv.ParseInt(item, 10, 64)
-	if err != nil {
+	val, err := toInteger(value)
+	switch {
+	case err == errNotNumber:
 		c.AddError(key, "Int")
 		return
+	case err != nil:
+		c.AddError(key, err.Error())
+		return
+	case !val.IsInt64():
+		c.AddError(key, errOverflow.Error())
+		return
 	}
 
-	c.Checkers = append(c.Checkers, IntValidityChecker{Key: key, Item: val, Rules: rules})
+	c.Checkers = append(c.Checkers, IntValidityChecker{Key: key, Item: val.Int64(), Rules: rules, Input: c.Data})
 }
 
-// Converter the given value to a float, using the same method as was used to convert to int.
+// Converts the given value to a float. Numbers are converted directly, and anything else is parsed as a string. NaN and
+// infinities fail with "Finite", unless the rules include "allow_nan" or "allow_inf".
 func (v ValidityParsers) ParseFloat(c *ValidityQueue, key string, value interface{}, rules []string) {
-	item := fmt.Sprintf("%v", value)
-
-	val, err := strconv.ParseFloat(item, 64)
+	val, err := toFloat(value)
 	if err != nil {
 		c.AddError(key, "Float")
 		return
 	}
 
-	c.Checkers = append(c.Checkers, FloatValidityChecker{Key: key, Item: val, Rules: rules})
+	if math.IsNaN(val) && !inSlice("allow_nan", rules) || math.IsInf(val, 0) && !inSlice("allow_inf", rules) {
+		c.AddError(key, "Finite")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, FloatValidityChecker{Key: key, Item: val, Rules: rules, Input: c.Data})
+}
+
+// Converts the given value to an exact decimal. See DecimalValidityChecker.
+func (v ValidityParsers) ParseDecimal(c *ValidityQueue, key string, value interface{}, rules []string) {
+	val, places, ok := parseDecimalPlaces(value)
+	if !ok {
+		c.AddError(key, "Decimal")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, DecimalValidityChecker{Key: key, Item: val, Places: places, Rules: rules, Input: c.Data})
+}
+
+// Converts the given value to a duration. See DurationValidityChecker.
+func (v ValidityParsers) ParseDuration(c *ValidityQueue, key string, value interface{}, rules []string) {
+	val, ok := toDuration(value)
+	if !ok {
+		c.AddError(key, "Duration")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, DurationValidityChecker{Key: key, Item: val, Rules: rules})
+}
+
+// Converts the given value to a size in bytes. See ByteSizeValidityChecker.
+func (v ValidityParsers) ParseByteSize(c *ValidityQueue, key string, value interface{}, rules []string) {
+	val, ok := toByteSize(value)
+	if !ok {
+		c.AddError(key, "ByteSize")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, ByteSizeValidityChecker{Key: key, Item: val, Rules: rules})
+}
+
+// Converts the given value to a point on the Earth. See LatLngValidityChecker.
+func (v ValidityParsers) ParseLatLng(c *ValidityQueue, key string, value interface{}, rules []string) {
+	val, ok := toLatLng(value)
+	if !ok {
+		c.AddError(key, "LatLng")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, LatLngValidityChecker{Key: key, Item: val, Rules: rules})
 }
 
 // Converts the given value to a string.
 func (v ValidityParsers) ParseString(c *ValidityQueue, key string, item interface{}, rules []string) {
-	c.Checkers = append(c.Checkers, StringValidityChecker{Key: key, Item: fmt.Sprintf("%s", item), Rules: rules})
+	c.Checkers = append(c.Checkers, StringValidityChecker{Key: key, Item: fmt.Sprintf("%v", item), Rules: rules, Input: c.Data})
+}
+
+// Converts the given value to an email address, parsing it according to RFC 5322. See EmailValidityChecker.
+func (v ValidityParsers) ParseEmail(c *ValidityQueue, key string, value interface{}, rules []string) {
+	address, local, domain, ok := parseEmail(fmt.Sprintf("%v", value))
+	if !ok {
+		c.AddError(key, "Email")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, EmailValidityChecker{
+		Key:    key,
+		Item:   formatAddrSpec(local, domain),
+		Name:   address.Name,
+		Local:  local,
+		Domain: domain,
+		Rules:  rules,
+	})
+}
+
+// Converts the given value to a *url.URL. Relative URLs are allowed here, use the "absolute" rule to reject them.
+func (v ValidityParsers) ParseURL(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parsed, err := url.Parse(fmt.Sprintf("%v", value))
+	if err != nil {
+		c.AddError(key, "URL")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, URLValidityChecker{Key: key, Item: parsed, Rules: rules})
+}
+
+// Converts the given value to a netip.Addr, netip.AddrPort or netip.Prefix. See IPValidityChecker.
+func (v ValidityParsers) ParseIP(c *ValidityQueue, key string, value interface{}, rules []string) {
+	checker, ok := parseIPChecker(fmt.Sprintf("%v", value))
+	if !ok {
+		c.AddError(key, "IP")
+		return
+	}
+
+	checker.Key   = key
+	checker.Rules = rules
+	c.Checkers = append(c.Checkers, checker)
+}
+
+// Converts the given value to a phone number in E.164 format. National numbers are allowed if a "default_region:CC"
+// rule gives the region they are in. See parsePhone.
+func (v ValidityParsers) ParsePhone(c *ValidityQueue, key string, value interface{}, rules []string) {
+	region, _ := findRuleArgument("default_region", rules[1:])
+
+	callingCode, national, ok := parsePhone(fmt.Sprintf("%v", value), region)
+	if !ok {
+		c.AddError(key, "Phone")
+		return
+	}
+
+	c.Checkers = append(c.Checkers, PhoneValidityChecker{
+		Key:         key,
+		Item:        "+" + callingCode + national,
+		CallingCode: callingCode,
+		National:    national,
+		Rules:       rules,
+	})
 }
@@ -0,0 +1,228 @@
+package validity
+
+import (
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// Embeds the IANA timezone database, so the timezone rule works the same everywhere, even on systems without one.
+	_ "time/tzdata"
+)
+
+// ISO reference tables, loaded from the data directory. Each file has one entry per line, with fields seperated by
+// spaces, and lines starting with # are comments. They are parsed the first time they're needed.
+
+//go:embed data/countries.txt
+var countriesData string
+
+//go:embed data/currencies.txt
+var currenciesData string
+
+//go:embed data/languages.txt
+var languagesData string
+
+var (
+	referenceOnce   sync.Once
+	countriesAlpha2 map[string]bool
+	countriesAlpha3 map[string]bool
+	currencies      map[string]int
+	languages       map[string]bool
+)
+
+// Region codes which aren't ISO 3166-1 countries, but which are commonly used in language tags.
+var extraRegions = map[string]bool{"EU": true, "UN": true, "XK": true}
+
+// Splits one of the data files into the fields on each line.
+func referenceLines(data string) [][]string {
+	lines := [][]string{}
+
+	for _, line := range strings.Split(data, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && !strings.HasPrefix(fields[0], "#") {
+			lines = append(lines, fields)
+		}
+	}
+
+	return lines
+}
+
+// Parses the data files into their lookup tables.
+func loadReference() {
+	countriesAlpha2 = map[string]bool{}
+	countriesAlpha3 = map[string]bool{}
+	for _, fields := range referenceLines(countriesData) {
+		countriesAlpha2[fields[0]] = true
+		countriesAlpha3[fields[1]] = true
+	}
+
+	currencies = map[string]int{}
+	for _, fields := range referenceLines(currenciesData) {
+		units, err := strconv.Atoi(fields[1])
+		if err != nil {
+			units = -1
+		}
+		currencies[fields[0]] = units
+	}
+
+	languages = map[string]bool{}
+	for _, fields := range referenceLines(languagesData) {
+		for _, code := range fields {
+			languages[code] = true
+		}
+	}
+}
+
+// Returns the number of decimal places used by the ISO 4217 currency, like 2 for "USD" or 0 for "JPY". ok is false if
+// the currency is unknown, or has no minor unit (like "XAU", gold).
+func CurrencyMinorUnits(code string) (units int, ok bool) {
+	referenceOnce.Do(loadReference)
+
+	units, exists := currencies[code]
+	return units, exists && units >= 0
+}
+
+// Returns whether every character of the string is an ASCII letter or digit, and its length is in the range.
+func isSubtag(s string, min int, max int, letters bool, digits bool) bool {
+	if len(s) < min || len(s) > max {
+		return false
+	}
+
+	for _, c := range s {
+		isLetter := c >= 'a' && c <= 'z'
+		isDigit  := c >= '0' && c <= '9'
+		if !(letters && isLetter || digits && isDigit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns whether the string is a well-formed BCP 47 language tag, like "en", "en-GB", "zh-Hant-TW" or "sr-Latn-RS",
+// whose language is a known ISO 639 code and whose region is a known ISO 3166-1 country or a UN M.49 area code. The
+// grandfathered irregular tags, like "i-klingon", are not accepted.
+func isLanguageTag(tag string) bool {
+	referenceOnce.Do(loadReference)
+
+	parts := strings.Split(strings.ToLower(tag), "-")
+	i     := 0
+
+	// Tags may be entirely private use, like "x-whatever".
+	if parts[0] != "x" {
+		if !isSubtag(parts[0], 2, 3, true, false) || !languages[parts[0]] {
+			return false
+		}
+		i++
+
+		// Up to three extended language subtags, like "zh-yue".
+		for n := 0; n < 3 && i < len(parts) && isSubtag(parts[i], 3, 3, true, false); n++ {
+			if !languages[parts[i]] {
+				return false
+			}
+			i++
+		}
+
+		// An optional script, like "Latn".
+		if i < len(parts) && isSubtag(parts[i], 4, 4, true, false) {
+			i++
+		}
+
+		// An optional region, like "GB" or "419".
+		if i < len(parts) && isSubtag(parts[i], 2, 2, true, false) {
+			region := strings.ToUpper(parts[i])
+			if !countriesAlpha2[region] && !extraRegions[region] {
+				return false
+			}
+			i++
+		} else if i < len(parts) && isSubtag(parts[i], 3, 3, false, true) {
+			i++
+		}
+
+		// Any number of variants, like "1996" or "fonipa", which may not repeat.
+		variants := map[string]bool{}
+		for i < len(parts) && (isSubtag(parts[i], 5, 8, true, true) ||
+			len(parts[i]) == 4 && parts[i][0] >= '0' && parts[i][0] <= '9' && isSubtag(parts[i], 4, 4, true, true)) {
+			if variants[parts[i]] {
+				return false
+			}
+			variants[parts[i]] = true
+			i++
+		}
+
+		// Extensions, like "u-ca-buddhist", each with a different singleton.
+		singletons := map[string]bool{}
+		for i < len(parts) && len(parts[i]) == 1 && parts[i] != "x" {
+			if !isSubtag(parts[i], 1, 1, true, true) || singletons[parts[i]] {
+				return false
+			}
+			singletons[parts[i]] = true
+			i++
+
+			start := i
+			for i < len(parts) && isSubtag(parts[i], 2, 8, true, true) {
+				i++
+			}
+			if i == start {
+				return false
+			}
+		}
+	}
+
+	// Finally, an optional private use section.
+	if i < len(parts) && parts[i] == "x" {
+		i++
+		if i == len(parts) {
+			return false
+		}
+		for ; i < len(parts); i++ {
+			if !isSubtag(parts[i], 1, 8, true, true) {
+				return false
+			}
+		}
+	}
+
+	return i == len(parts)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes for uppercase ISO 3166-1 alpha-2 country codes, like "GB".
+func (v StringValidityChecker) ValidateCountry() bool {
+	referenceOnce.Do(loadReference)
+
+	return countriesAlpha2[v.Item]
+}
+
+// Passes for uppercase ISO 3166-1 alpha-3 country codes, like "GBR".
+func (v StringValidityChecker) ValidateCountryAlpha3() bool {
+	referenceOnce.Do(loadReference)
+
+	return countriesAlpha3[v.Item]
+}
+
+// Passes for uppercase ISO 4217 currency codes, like "USD".
+func (v StringValidityChecker) ValidateCurrency() bool {
+	referenceOnce.Do(loadReference)
+
+	_, exists := currencies[v.Item]
+	return exists
+}
+
+// Passes for BCP 47 language tags, like "en" or "pt-BR". See isLanguageTag.
+func (v StringValidityChecker) ValidateLanguage() bool {
+	return v.Item != "" && isLanguageTag(v.Item)
+}
+
+// Passes for IANA timezone names, like "Europe/London" or "UTC".
+func (v StringValidityChecker) ValidateTimezone() bool {
+	if v.Item == "" || v.Item == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(v.Item)
+	return err == nil
+}
@@ -0,0 +1,133 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestStringValidateCountryPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "country"}}
+
+	for _, value := range []string{"GB", "US", "AX", "SS"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String country validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCountryFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "country"}}
+
+	for _, value := range []string{"UK", "gb", "GBR", "XX", ""} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String country validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateCountryAlpha3Pass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "country_alpha3"}}
+
+	for _, value := range []string{"GBR", "USA", "ALA"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String country_alpha3 validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCountryAlpha3Fail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "country_alpha3"}}
+
+	for _, value := range []string{"UKR1", "GB", "gbr", "XXX"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String country_alpha3 validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateCurrencyPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "currency"}}
+
+	for _, value := range []string{"USD", "EUR", "JPY", "XAU"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String currency validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateCurrencyFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "currency"}}
+
+	for _, value := range []string{"usd", "US", "XYZ", "DEM"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String currency validator does not fail %q.", value)
+		}
+	}
+}
+func TestCurrencyMinorUnits(t *testing.T) {
+	for code, expected := range map[string]int{"USD": 2, "JPY": 0, "BHD": 3, "CLF": 4} {
+		if units, ok := CurrencyMinorUnits(code); !ok || units != expected {
+			t.Errorf("Expected %s to have %d minor units, got %d.", code, expected, units)
+		}
+	}
+	if _, ok := CurrencyMinorUnits("XAU"); ok {
+		t.Errorf("Currencies without minor units should not be ok.")
+	}
+	if _, ok := CurrencyMinorUnits("XYZ"); ok {
+		t.Errorf("Unknown currencies should not be ok.")
+	}
+}
+
+
+
+func TestStringValidateLanguagePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "language"}}
+
+	for _, value := range []string{
+		"en", "en-GB", "EN-gb", "zh-Hant-TW", "sr-Latn-RS", "es-419", "fil", "de-CH-1996", "en-u-ca-gregory",
+		"x-private", "en-x-custom",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String language validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateLanguageFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "language"}}
+
+	for _, value := range []string{
+		"", "english", "qq", "en-ZZ", "en-", "en--GB", "en-u", "de-1996-1996", "en-a-foo-a-bar", "i-klingon", "x",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String language validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateTimezonePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "timezone"}}
+
+	for _, value := range []string{"Europe/London", "America/New_York", "UTC", "Asia/Kolkata"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String timezone validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateTimezoneFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "timezone"}}
+
+	for _, value := range []string{"", "Local", "Mars/Olympus", "../../etc/passwd"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String timezone validator does not fail %q.", value)
+		}
+	}
+}
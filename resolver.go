@@ -0,0 +1,34 @@
+package validity
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+// LookupTimeout bounds each lookup made through EmailResolver and URLResolver, so that a slow DNS server can't hold up
+// validation. Lookups which take longer fail.
+var LookupTimeout = 5 * time.Second
+
+// Returns a context for a single lookup, which ends after LookupTimeout.
+func lookupContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), LookupTimeout)
+}
+
+// MXResolver looks up the mail exchangers for a domain. It has the same signature as (*net.Resolver).LookupMX, so
+// a *net.Resolver can be used directly, and tests can swap in a fake.
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+}
+
+// EmailResolver is used by the Email "mx" rule. It defaults to net.DefaultResolver.
+var EmailResolver MXResolver = net.DefaultResolver
+
+// IPResolver looks up the addresses for a host. It has the same signature as (*net.Resolver).LookupIPAddr, so a
+// *net.Resolver can be used directly, and tests can swap in a fake.
+type IPResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// URLResolver is used by the URL "public_url" rule. It defaults to net.DefaultResolver.
+var URLResolver IPResolver = net.DefaultResolver
@@ -0,0 +1,116 @@
+package validity
+
+import (
+	"math/big"
+	"reflect"
+)
+
+// Sized integer types, like Int8 and Uint64. Values must fit in the Go type of the same name, failing with "Overflow"
+// if they don't, and end up in the results Data as that type. Signed types are checked by IntValidityChecker and
+// unsigned ones by UintValidityChecker, so they have the same rules as Int.
+
+// The Go type of each sized kind, used to convert items back for the results Data.
+var sizedTypes = map[reflect.Kind]reflect.Type{
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int8:    reflect.TypeOf(int8(0)),
+	reflect.Int16:   reflect.TypeOf(int16(0)),
+	reflect.Int32:   reflect.TypeOf(int32(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint:    reflect.TypeOf(uint(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Uint16:  reflect.TypeOf(uint16(0)),
+	reflect.Uint32:  reflect.TypeOf(uint32(0)),
+	reflect.Uint64:  reflect.TypeOf(uint64(0)),
+	reflect.Uintptr: reflect.TypeOf(uintptr(0)),
+}
+
+// Returns the smallest and largest values of the integer kind.
+func sizedRange(kind reflect.Kind) (*big.Int, *big.Int) {
+	bits := uint(sizedTypes[kind].Bits())
+	one  := big.NewInt(1)
+
+	if kind >= reflect.Uint && kind <= reflect.Uintptr {
+		return big.NewInt(0), new(big.Int).Sub(new(big.Int).Lsh(one, bits), one)
+	}
+
+	max := new(big.Int).Sub(new(big.Int).Lsh(one, bits-1), one)
+
+	return new(big.Int).Sub(new(big.Int).Neg(max), one), max
+}
+
+// Converts the value to an integer of the given kind, and adds a checker for it. typeName is the error given if the
+// value isn't a number at all.
+func parseSizedInt(c *ValidityQueue, key string, value interface{}, rules []string, kind reflect.Kind, typeName string) {
+	val, err := toInteger(value)
+	switch {
+	case err == errNotNumber:
+		c.AddError(key, typeName)
+		return
+	case err != nil:
+		c.AddError(key, err.Error())
+		return
+	}
+
+	if min, max := sizedRange(kind); val.Cmp(min) < 0 || val.Cmp(max) > 0 {
+		c.AddError(key, errOverflow.Error())
+		return
+	}
+
+	if kind >= reflect.Uint && kind <= reflect.Uintptr {
+		c.Checkers = append(c.Checkers, UintValidityChecker{
+			Key:   key,
+			Item:  val.Uint64(),
+			Rules: rules,
+			Kind:  kind,
+			Input: c.Data,
+		})
+	} else {
+		c.Checkers = append(c.Checkers, IntValidityChecker{
+			Key:   key,
+			Item:  val.Int64(),
+			Rules: rules,
+			Kind:  kind,
+			Input: c.Data,
+		})
+	}
+}
+
+func (v ValidityParsers) ParseInt8(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Int8, "Int8")
+}
+
+func (v ValidityParsers) ParseInt16(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Int16, "Int16")
+}
+
+func (v ValidityParsers) ParseInt32(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Int32, "Int32")
+}
+
+func (v ValidityParsers) ParseInt64(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Int64, "Int64")
+}
+
+func (v ValidityParsers) ParseUint(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uint, "Uint")
+}
+
+func (v ValidityParsers) ParseUint8(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uint8, "Uint8")
+}
+
+func (v ValidityParsers) ParseUint16(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uint16, "Uint16")
+}
+
+func (v ValidityParsers) ParseUint32(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uint32, "Uint32")
+}
+
+func (v ValidityParsers) ParseUint64(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uint64, "Uint64")
+}
+
+func (v ValidityParsers) ParseUintptr(c *ValidityQueue, key string, value interface{}, rules []string) {
+	parseSizedInt(c, key, value, rules, reflect.Uintptr, "Uintptr")
+}
@@ -0,0 +1,102 @@
+package validity
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSizedIntRanges(t *testing.T) {
+	tests := []struct {
+		Type  string
+		Pass  []interface{}
+		Fail  []interface{}
+		Data  interface{}
+	}{
+		{"Int8", []interface{}{-128, 127, "100"}, []interface{}{-129, 128, "300"}, int8(127)},
+		{"Int16", []interface{}{-32768, 32767}, []interface{}{-32769, 32768}, int16(32767)},
+		{"Int32", []interface{}{math.MinInt32, math.MaxInt32}, []interface{}{math.MaxInt32 + 1}, int32(math.MaxInt32)},
+		{"Int64", []interface{}{int64(math.MinInt64), "9223372036854775807"}, []interface{}{"9223372036854775808"},
+			int64(math.MaxInt64)},
+		{"Uint8", []interface{}{0, 255, 1e2}, []interface{}{-1, 256, 300}, uint8(255)},
+		{"Uint16", []interface{}{0, 65535}, []interface{}{-1, 65536}, uint16(65535)},
+		{"Uint32", []interface{}{0, uint32(math.MaxUint32)}, []interface{}{-1, "4294967296"}, uint32(math.MaxUint32)},
+		{"Uint64", []interface{}{0, uint64(math.MaxUint64)}, []interface{}{-1, "18446744073709551616"},
+			uint64(math.MaxUint64)},
+	}
+
+	for _, test := range tests {
+		for _, value := range test.Pass {
+			results := ValidateMap(map[string]interface{}{"foo": value}, ValidationRules{"foo": []string{test.Type}})
+			if !results.IsValid {
+				t.Errorf("%#v should pass the %s type. Errors: %v", value, test.Type, results.Errors)
+			}
+		}
+
+		for _, value := range test.Fail {
+			results := ValidateMap(map[string]interface{}{"foo": value}, ValidationRules{"foo": []string{test.Type}})
+			if results.IsValid || results.Errors["foo"][0] != "Overflow" {
+				t.Errorf("%#v should overflow the %s type. Errors: %v", value, test.Type, results.Errors)
+			}
+		}
+
+		// The second value passing is always the largest for the type.
+		results := ValidateMap(map[string]interface{}{"foo": test.Pass[1]}, ValidationRules{"foo": []string{test.Type}})
+		if results.Data["foo"] != test.Data {
+			t.Errorf("The %s type should give %#v in Data, got %#v.", test.Type, test.Data, results.Data["foo"])
+		}
+	}
+
+	results := ValidateMap(map[string]interface{}{"foo": "nope"}, ValidationRules{"foo": []string{"Uint16"}})
+	if results.IsValid || results.Errors["foo"][0] != "Uint16" {
+		t.Errorf("Values which aren't numbers should fail with the type name. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestUintRules(t *testing.T) {
+	data  := map[string]interface{}{"a": uint64(math.MaxUint64), "b": 50, "c": 7}
+	rules := ValidationRules{
+		"a": []string{"Uint64", "min:9223372036854775808", "digits:20"},
+		"b": []string{"Uint8", "between:10,60", "max:50", "min:-5", "not_in:1,2"},
+		"c": []string{"Uint", "in:5,6", "max:-1"},
+	}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 0 || len(results.Errors["c"]) != 2 {
+		t.Errorf("Uint rules do not work. Errors: %v", results.Errors)
+	}
+}
+
+
+
+type TestStructSized struct {
+	A int
+	B int8
+	C int16
+	D int32
+	E int64
+	F uint
+	G uint8
+	H uint16
+	I uint32
+	J uint64
+	K uintptr
+}
+
+func TestInferSizedTypes(t *testing.T) {
+	results := ValidateStructTags(TestStructSized{B: 1, C: 2, H: 3, J: math.MaxUint64, K: 4})
+	if !results.IsValid {
+		t.Fatalf("Sized struct fields should validate. Errors: %v", results.Errors)
+	}
+
+	expected := map[string]interface{}{
+		"A": int64(0), "B": int8(1), "C": int16(2), "D": int32(0), "E": int64(0), "F": uint(0), "G": uint8(0),
+		"H": uint16(3), "I": uint32(0), "J": uint64(math.MaxUint64), "K": uintptr(4),
+	}
+
+	if !reflect.DeepEqual(results.Data, expected) {
+		t.Errorf("Sized struct fields should keep their types. Got %#v", results.Data)
+	}
+}
@@ -0,0 +1,85 @@
+package validity
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Strict typing, for ValidationOptions.Strict. Normally values are coerced to the type under validation, so the bool
+// true is "true" to String and "42" is 42 to Int. In strict mode, each type only accepts the kinds of value listed
+// here, and anything else fails with "TypeMismatch:" and the Go type it actually got, like "TypeMismatch:bool".
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+func isIntegerKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Uintptr
+}
+
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// Integers, and json.Number. A json.Number which isn't integral is let through to fail with "Fractional".
+func strictInteger(value reflect.Value) bool {
+	return isIntegerKind(value.Kind()) || value.Type() == jsonNumberType
+}
+
+// Any kind of number, including json.Number.
+func strictNumber(value reflect.Value) bool {
+	return strictInteger(value) || isFloatKind(value.Kind())
+}
+
+// Strings, but not json.Number, which is a number in JSON.
+func strictString(value reflect.Value) bool {
+	return value.Kind() == reflect.String && value.Type() != jsonNumberType
+}
+
+// The values accepted by each type in strict mode. Types not listed aren't checked.
+var strictTypes = map[string]func(value reflect.Value) bool{
+	"Int":     strictInteger,
+	"Int8":    strictInteger,
+	"Int16":   strictInteger,
+	"Int32":   strictInteger,
+	"Int64":   strictInteger,
+	"Uint":    strictInteger,
+	"Uint8":   strictInteger,
+	"Uint16":  strictInteger,
+	"Uint32":  strictInteger,
+	"Uint64":  strictInteger,
+	"Uintptr": strictInteger,
+	"Float":   strictNumber,
+	"Decimal": strictNumber,
+	"String":  strictString,
+	"Email":   strictString,
+	"URL":     strictString,
+	"IP":      strictString,
+	"Phone":   strictString,
+	"Duration": func(value reflect.Value) bool {
+		return strictString(value) || value.Type() == durationType
+	},
+	"ByteSize": func(value reflect.Value) bool {
+		return strictString(value) || isIntegerKind(value.Kind())
+	},
+	"LatLng": func(value reflect.Value) bool {
+		return strictString(value) || value.Kind() == reflect.Map || value.Type() == reflect.TypeOf(LatLng{})
+	},
+}
+
+// Returns the name of the value's type if strict mode doesn't let it be converted to the validation type, or "" if
+// it does.
+func strictMismatch(validationType string, item interface{}) string {
+	accepts, ok := strictTypes[validationType]
+	if !ok {
+		return ""
+	}
+
+	if item == nil {
+		return "nil"
+	}
+
+	if value := reflect.ValueOf(item); !accepts(value) {
+		return value.Type().String()
+	}
+
+	return ""
+}
@@ -0,0 +1,99 @@
+package validity
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStrictMismatch(t *testing.T) {
+	tests := []struct {
+		Type     string
+		Value    interface{}
+		Mismatch string
+	}{
+		{"Int", 42, ""},
+		{"Int", uint8(42), ""},
+		{"Int", json.Number("42"), ""},
+		{"Int", "42", "string"},
+		{"Int", 42.0, "float64"},
+		{"Int", true, "bool"},
+		{"Int", nil, "nil"},
+		{"Uint16", 7, ""},
+		{"Float", 1.5, ""},
+		{"Float", 1, ""},
+		{"Float", json.Number("1.5"), ""},
+		{"Float", "1.5", "string"},
+		{"Decimal", "19.99", "string"},
+		{"String", "yes", ""},
+		{"String", true, "bool"},
+		{"String", json.Number("1"), "json.Number"},
+		{"String", []interface{}{"a"}, "[]interface {}"},
+		{"Email", map[string]interface{}{}, "map[string]interface {}"},
+		{"Duration", "1m", ""},
+		{"Duration", time.Minute, ""},
+		{"Duration", 60, "int"},
+		{"ByteSize", 1024, ""},
+		{"ByteSize", 1.5, "float64"},
+	}
+
+	for _, test := range tests {
+		if actual := strictMismatch(test.Type, test.Value); actual != test.Mismatch {
+			t.Errorf("Strict %s given %#v should mismatch %q, got %q.", test.Type, test.Value, test.Mismatch, actual)
+		}
+	}
+}
+
+
+
+func TestStrictOption(t *testing.T) {
+	data := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(`{"name": true, "age": "42", "count": 42, "ratio": 0.5, "half": 2.5}`))
+	decoder.UseNumber()
+	decoder.Decode(&data)
+
+	rules := ValidationRules{
+		"name":    []string{"String"},
+		"age":     []string{"Int"},
+		"count":   []string{"Int"},
+		"ratio":   []string{"Float"},
+		"half":    []string{"Int"},
+		"default": []string{"Int", "default:5"},
+	}
+
+	results := ValidateMapWithOptions(data, rules, ValidationOptions{Strict: true})
+	if results.Errors["name"][0] != "TypeMismatch:bool" || results.Errors["age"][0] != "TypeMismatch:string" ||
+		results.Errors["half"][0] != "Fractional" || results.Data["count"] != int64(42) ||
+		results.Data["ratio"] != 0.5 || results.Data["default"] != int64(5) {
+		t.Errorf("Strict option does not reject coercion. Results: %v", results)
+	}
+
+	results = ValidateMap(data, rules)
+	if results.Data["name"] != "true" || results.Data["age"] != int64(42) {
+		t.Errorf("Coercion should still happen without the strict option. Results: %v", results)
+	}
+}
+
+
+
+func TestStrictOptionWithLocale(t *testing.T) {
+	data := map[string]interface{}{"price": "1.234,56", "qty": "12", "plain": "12"}
+	rules := ValidationRules{
+		"price": []string{"Float", "locale:de"},
+		"qty":   []string{"Int", "locale:de"},
+		"plain": []string{"Int"},
+	}
+
+	results := ValidateMapWithOptions(data, rules, ValidationOptions{Strict: true})
+	if results.Data["price"] != 1234.56 || results.Data["qty"] != int64(12) ||
+		len(results.Errors["plain"]) != 1 || results.Errors["plain"][0] != "TypeMismatch:string" {
+		t.Errorf("Strict option does not let through numbers written for a locale. Results: %v", results)
+	}
+
+	results = ValidateMapWithOptions(data, ValidationRules{"plain": []string{"Int"}},
+		ValidationOptions{Strict: true, Locale: "de"})
+	if results.Data["plain"] != int64(12) {
+		t.Errorf("Strict option does not let through numbers written for the options' locale. Results: %v", results)
+	}
+}
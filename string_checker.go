@@ -3,15 +3,20 @@ package validity
 import (
 	"strconv"
 	"regexp"
+	"strings"
 	"net"
 	"net/url"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 type StringValidityChecker struct {
 	Key   string
 	Rules []string
 	Item  string
+	// Input is the whole of the data under validation, for rules which depend on other fields.
+	Input map[string]interface{}
 }
 
 func (v StringValidityChecker) GetKey() string {
@@ -42,6 +47,40 @@ func (v StringValidityChecker) checkRegexp(r string) bool {
 	return expression.MatchString(v.Item)
 }
 
+// Returns whether every character passes the test. Marks are allowed after a letter or digit, so a decomposed "e" with
+// an accent counts as a letter.
+func (v StringValidityChecker) checkRunes(test func(r rune) bool) bool {
+	afterAlnum := false
+
+	for _, r := range v.Item {
+		if unicode.IsMark(r) && afterAlnum {
+			continue
+		}
+		if !test(r) {
+			return false
+		}
+		afterAlnum = unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	return true
+}
+
+// Gets the length of the item, in the unit given by a "length_unit" rule. This is runes (code points) by default, but
+// may also be "bytes" or "graphemes" (user-perceived characters, so an emoji flag is one rather than two). Any other
+// unit fails the length_unit rule itself.
+func (v StringValidityChecker) length() int {
+	unit, _ := findRuleArgument("length_unit", v.Rules)
+
+	switch unit {
+	case "bytes":
+		return len(v.Item)
+	case "graphemes":
+		return graphemeCount(v.Item)
+	default:
+		return utf8.RuneCountInString(v.Item)
+	}
+}
+
 func (v StringValidityChecker) parseIP() net.IP {
 	return net.ParseIP(v.Item)
 }
@@ -55,21 +94,37 @@ func (v StringValidityChecker) ValidateAccepted() bool {
 }
 
 func (v StringValidityChecker) ValidateAlpha() bool {
+	return v.checkRunes(unicode.IsLetter)
+}
+
+func (v StringValidityChecker) ValidateAlphaAscii() bool {
 	return v.checkRegexp("^[A-Za-z]*$")
 }
 
 func (v StringValidityChecker) ValidateAlphaDash() bool {
+	return v.checkRunes(func(r rune) bool {
+		return unicode.IsLetter(r) || r == '-' || r == '_'
+	})
+}
+
+func (v StringValidityChecker) ValidateAlphaDashAscii() bool {
 	return v.checkRegexp("^[A-Za-z\\-_]*$")
 }
 
 func (v StringValidityChecker) ValidateAlphaNum() bool {
+	return v.checkRunes(func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	})
+}
+
+func (v StringValidityChecker) ValidateAlphaNumAscii() bool {
 	return v.checkRegexp("^[A-Za-z0-9]*$")
 }
 
 func (v StringValidityChecker) ValidateBetween(min string, max string) bool {
-	length := len(v.Item)
+	length := v.length()
 
-	return length > v.toInt(min) && length < v.toInt(max)
+	return length >= v.toInt(min) && length <= v.toInt(max)
 }
 
 func (v StringValidityChecker) ValidateDate() bool {
@@ -78,8 +133,11 @@ func (v StringValidityChecker) ValidateDate() bool {
 	return err == nil
 }
 
+// Passes for bare RFC 5322 addresses, like "user@example.com". Use the Email type for display names and other options.
 func (v StringValidityChecker) ValidateEmail() bool {
-	return v.checkRegexp("^.+\\@.+\\..+$")
+	address, _, _, ok := parseEmail(v.Item)
+
+	return ok && address.Name == "" && !strings.ContainsAny(v.Item, "<>")
 }
 
 func (v StringValidityChecker) ValidateIpv4() bool {
@@ -91,7 +149,7 @@ func (v StringValidityChecker) ValidateIpv4() bool {
 func (v StringValidityChecker) ValidateIpv6() bool {
 	parsed := v.parseIP()
 
-	return parsed != nil && parsed.To16() != nil
+	return parsed != nil && parsed.To4() == nil
 }
 
 func (v StringValidityChecker) ValidateIp() bool {
@@ -99,15 +157,20 @@ func (v StringValidityChecker) ValidateIp() bool {
 }
 
 func (v StringValidityChecker) ValidateLen(length string) bool {
-	return len(v.Item) != v.toInt(length)
+	return v.length() == v.toInt(length)
+}
+
+// Passes if the unit is one which the length rules know how to count in.
+func (v StringValidityChecker) ValidateLengthUnit(unit string) bool {
+	return unit == "runes" || unit == "bytes" || unit == "graphemes"
 }
 
 func (v StringValidityChecker) ValidateMax(length string) bool {
-	return len(v.Item) <= v.toInt(length)
+	return v.length() <= v.toInt(length)
 }
 
 func (v StringValidityChecker) ValidateMin(length string) bool {
-	return len(v.Item) >= v.toInt(length)
+	return v.length() >= v.toInt(length)
 }
 
 func (v StringValidityChecker) ValidateRegexp(r string) bool {
@@ -119,3 +182,23 @@ func (v StringValidityChecker) ValidateUrl() bool {
 
 	return err == nil
 }
+
+func (v StringValidityChecker) ValidateIn(values ...string) bool {
+	for _, value := range values {
+		if v.Item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v StringValidityChecker) ValidateNotIn(values ...string) bool {
+	return !v.ValidateIn(values...)
+}
+
+func (v StringValidityChecker) ValidateEnum(name string) bool {
+	values, exists := enumValues(name)
+
+	return exists && v.ValidateIn(values...)
+}
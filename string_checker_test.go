@@ -64,6 +64,15 @@ func TestStringValidateAlphaDashFail(t *testing.T) {
 		t.Errorf("String alpha_dash validator does not fail.")
 	}
 }
+func TestStringValidateAlphaDashDigitsFail(t *testing.T) {
+	data := TestStruct{Foo: "Alpha_Dash-42"}
+	rules := ValidationRules{"Foo": []string{"String", "alpha_dash_ascii"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String alpha_dash_ascii validator does not fail.")
+	}
+}
 
 
 
@@ -157,6 +166,15 @@ func TestStringValidateEmailFail(t *testing.T) {
 		t.Errorf("String email validator does not fail.")
 	}
 }
+func TestStringValidateEmailFailSpaces(t *testing.T) {
+	data := map[string]interface{}{"a": "a b@c.d", "b": "Connor <connor@peet.io>", "c": "connor@peet.io\n"}
+	rules := ValidationRules{"a": []string{"String", "email"}, "b": []string{"String", "email"}, "c": []string{"String", "email"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors) != 3 {
+		t.Errorf("String email validator does not fail on malformed addresses. Errors: %v", results.Errors)
+	}
+}
 
 
 
@@ -199,6 +217,15 @@ func TestStringValidateIpv6Fail(t *testing.T) {
 		t.Errorf("String ipv6 validator does not fail.")
 	}
 }
+func TestStringValidateIpv6FailOnIpv4(t *testing.T) {
+	data := TestStruct{Foo: "127.0.0.1"}
+	rules := ValidationRules{"Foo": []string{"String", "Ipv6"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String ipv6 validator does not fail on ipv4 addresses.")
+	}
+}
 
 
 
@@ -283,3 +310,184 @@ func TestStringValidateUrlFail(t *testing.T) {
 		t.Errorf("String url validator does not fail.")
 	}
 }
+
+
+
+func TestStringValidateInPass(t *testing.T) {
+	data := TestStruct{Foo: "b"}
+	rules := ValidationRules{"Foo": []string{"String", "in:a,b,c"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String in validator does not pass.")
+	}
+}
+func TestStringValidateInFail(t *testing.T) {
+	data := TestStruct{Foo: "d"}
+	rules := ValidationRules{"Foo": []string{"String", "in:a,b,c"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String in validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidateNotInPass(t *testing.T) {
+	data := TestStruct{Foo: "d"}
+	rules := ValidationRules{"Foo": []string{"String", "not_in:a,b,c"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String not_in validator does not pass.")
+	}
+}
+func TestStringValidateNotInFail(t *testing.T) {
+	data := TestStruct{Foo: "a"}
+	rules := ValidationRules{"Foo": []string{"String", "not_in:a,b,c"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String not_in validator does not fail.")
+	}
+}
+
+
+
+func TestStringValidateAlphaUnicodePass(t *testing.T) {
+	data := TestStruct{Foo: "JoséÅngströmΔέλτα"}
+	rules := ValidationRules{"Foo": []string{"String", "alpha"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String alpha validator does not pass unicode letters.")
+	}
+}
+func TestStringValidateAlphaCombiningMarkPass(t *testing.T) {
+	data := TestStruct{Foo: "Jose\u0301"}
+	rules := ValidationRules{"Foo": []string{"String", "alpha"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String alpha validator does not pass combining marks.")
+	}
+}
+func TestStringValidateAlphaNumDashUnicode(t *testing.T) {
+	data := map[string]interface{}{"a": "名前123", "b": "jürgen_müller-x", "c": "jürgen müller", "d": "jürgen_2"}
+	rules := ValidationRules{"a": []string{"String", "alpha_num"}, "b": []string{"String", "alpha_dash"}, "c": []string{"String", "alpha_dash"},
+		"d": []string{"String", "alpha_dash"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 0 || len(results.Errors["b"]) != 0 || len(results.Errors["c"]) != 1 ||
+		len(results.Errors["d"]) != 1 {
+		t.Errorf("String alpha_num and alpha_dash validators do not handle unicode. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestStringValidateAlphaAsciiPass(t *testing.T) {
+	data := map[string]interface{}{"a": "Jose", "b": "Jose42", "c": "Jose_x-y"}
+	rules := ValidationRules{"a": []string{"String", "alpha_ascii"}, "b": []string{"String", "alpha_num_ascii"}, "c": []string{"String", "alpha_dash_ascii"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid {
+		t.Errorf("String ascii alpha validators do not pass. Errors: %v", results.Errors)
+	}
+}
+func TestStringValidateAlphaAsciiFail(t *testing.T) {
+	data := map[string]interface{}{"a": "José", "b": "José42", "c": "José_x-y"}
+	rules := ValidationRules{"a": []string{"String", "alpha_ascii"}, "b": []string{"String", "alpha_num_ascii"}, "c": []string{"String", "alpha_dash_ascii"}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors) != 3 {
+		t.Errorf("String ascii alpha validators do not fail on unicode. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestStringValidateLenPass(t *testing.T) {
+	data := TestStruct{Foo: "café"}
+	rules := ValidationRules{"Foo": []string{"String", "len:4"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String len validator does not pass.")
+	}
+}
+func TestStringValidateLenFail(t *testing.T) {
+	data := TestStruct{Foo: "cafés"}
+	rules := ValidationRules{"Foo": []string{"String", "len:4"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String len validator does not fail.")
+	}
+}
+
+
+
+func TestStringLengthUnitBytes(t *testing.T) {
+	data := TestStruct{Foo: "café"}
+	rules := ValidationRules{"Foo": []string{"String", "length_unit:bytes", "len:5"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String length unit bytes does not count bytes. Errors: %v", results.Errors)
+	}
+}
+func TestStringLengthUnitGraphemes(t *testing.T) {
+	data := map[string]interface{}{
+		"accent":  "café",
+		"flag":    "\U0001F1EF\U0001F1F5\U0001F1E9\U0001F1EA",
+		"family":  "\U0001F468\u200d\U0001F469\u200d\U0001F467",
+		"skin":    "\U0001F44D\U0001F3FD!",
+		"hangul":  "한가",
+		"newline": "a\r\nb",
+	}
+	rules := ValidationRules{
+		"accent":  []string{"String", "length_unit:graphemes", "len:4"},
+		"flag":    []string{"String", "length_unit:graphemes", "len:2"},
+		"family":  []string{"String", "length_unit:graphemes", "len:1"},
+		"skin":    []string{"String", "length_unit:graphemes", "len:2"},
+		"hangul":  []string{"String", "length_unit:graphemes", "len:2"},
+		"newline": []string{"String", "length_unit:graphemes", "len:3"},
+	}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid {
+		t.Errorf("String length unit graphemes does not count graphemes. Errors: %v", results.Errors)
+	}
+}
+func TestStringValidateLengthUnitPass(t *testing.T) {
+	data := TestStruct{Foo: "café"}
+	rules := ValidationRules{"Foo": []string{"String", "length_unit:runes", "len:4"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String length_unit validator does not pass.")
+	}
+}
+func TestStringValidateLengthUnitFail(t *testing.T) {
+	data := TestStruct{Foo: "café"}
+	rules := ValidationRules{"Foo": []string{"String", "length_unit:words", "len:4"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid || results.Errors["Foo"][0] != "LengthUnit" {
+		t.Errorf("String length_unit validator does not fail. Errors: %v", results.Errors)
+	}
+}
+
+
+
+func TestStringParsesOtherTypes(t *testing.T) {
+	data := map[string]interface{}{"a": 55, "b": true, "c": 1.5}
+	rules := ValidationRules{"a": []string{"String"}, "b": []string{"String"}, "c": []string{"String"}}
+
+	results := ValidateMap(data, rules)
+	if results.Data["a"] != "55" || results.Data["b"] != "true" || results.Data["c"] != "1.5" {
+		t.Errorf("String should format other types like fmt.Sprint. Data: %v", results.Data)
+	}
+}
@@ -0,0 +1,288 @@
+package validity
+
+import (
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Formats for identifiers which we'd otherwise end up writing regex rules for. These are all rules on the
+// StringValidityChecker, split out here as there are a fair few of them.
+
+var (
+	uuidExpression     = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	ulidExpression     = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+	slugExpression     = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+	hexColorExpression = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	semverExpression   = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+	semverPartialExpression = regexp.MustCompile(`^v?(\d+|[xX*])(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?` +
+		`(?:-([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?(?:\+[0-9a-zA-Z-.]+)?$`)
+)
+
+// A parsed semantic version. Build metadata is dropped, as it doesn't affect precedence.
+type semver struct {
+	parts      [3]int64
+	prerelease []string
+}
+
+// Parses a full semantic version, like "1.2.3-beta.1+build". A leading "v" is allowed.
+func parseSemver(s string) (semver, bool) {
+	match := semverExpression.FindStringSubmatch(s)
+	if match == nil {
+		return semver{}, false
+	}
+
+	version := semver{}
+	for i := 0; i < 3; i++ {
+		part, err := strconv.ParseInt(match[i+1], 10, 64)
+		if err != nil {
+			return semver{}, false
+		}
+		version.parts[i] = part
+	}
+	if match[4] != "" {
+		version.prerelease = strings.Split(match[4], ".")
+	}
+
+	return version, true
+}
+
+// Compares two versions by semver precedence, returning -1, 0 or 1.
+func (a semver) compare(b semver) int {
+	for i := 0; i < 3; i++ {
+		if a.parts[i] != b.parts[i] {
+			return compareInt64(a.parts[i], b.parts[i])
+		}
+	}
+
+	// A version without a prerelease is greater than one with.
+	switch {
+	case len(a.prerelease) == 0 && len(b.prerelease) == 0:
+		return 0
+	case len(a.prerelease) == 0:
+		return 1
+	case len(b.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(a.prerelease) && i < len(b.prerelease); i++ {
+		x, xErr := strconv.ParseInt(a.prerelease[i], 10, 64)
+		y, yErr := strconv.ParseInt(b.prerelease[i], 10, 64)
+
+		switch {
+		case xErr == nil && yErr == nil:
+			if x != y {
+				return compareInt64(x, y)
+			}
+		case xErr == nil:
+			return -1
+		case yErr == nil:
+			return 1
+		case a.prerelease[i] != b.prerelease[i]:
+			if a.prerelease[i] < b.prerelease[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return compareInt64(int64(len(a.prerelease)), int64(len(b.prerelease)))
+}
+
+func compareInt64(a int64, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Checks whether the version satisfies a range. Ranges are made of comparators separated by spaces, all of which must
+// hold, and alternatives separated by "||". Comparators are an operator (=, >, >=, <, <=, ^ or ~, defaulting to =)
+// followed by a version, which may be partial like "1.2" or "1.x". Versions are compared by semver precedence alone,
+// so unlike npm, prereleases like "1.3.0-beta" satisfy ranges such as ">=1.2.0" too.
+func satisfiesSemverRange(version semver, constraint string) bool {
+	for _, alternative := range strings.Split(constraint, "||") {
+		comparators := strings.Fields(alternative)
+		satisfied   := len(comparators) > 0
+
+		for _, comparator := range comparators {
+			if !satisfiesSemverComparator(version, comparator) {
+				satisfied = false
+				break
+			}
+		}
+
+		if satisfied {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks a single comparator, like ">=1.2.0" or "^1.2".
+func satisfiesSemverComparator(version semver, comparator string) bool {
+	operator := comparator[:len(comparator)-len(strings.TrimLeft(comparator, "<>=^~"))]
+	match    := semverPartialExpression.FindStringSubmatch(comparator[len(operator):])
+	if match == nil {
+		return false
+	}
+
+	// Work out the lower bound, and how many parts were actually given.
+	lower := semver{}
+	given := 0
+	for i := 0; i < 3; i++ {
+		if match[i+1] == "" || strings.ContainsAny(match[i+1], "xX*") {
+			break
+		}
+		lower.parts[i], _ = strconv.ParseInt(match[i+1], 10, 64)
+		given++
+	}
+	if match[4] != "" && given == 3 {
+		lower.prerelease = strings.Split(match[4], ".")
+	}
+
+	// The exclusive upper bound for a partial version, so "1.2" covers everything up to "1.3.0-0".
+	upper := func(at int) semver {
+		bound := semver{parts: lower.parts, prerelease: []string{"0"}}
+		if at < 0 {
+			return semver{parts: [3]int64{1 << 62, 0, 0}}
+		}
+		bound.parts[at]++
+		for i := at + 1; i < 3; i++ {
+			bound.parts[i] = 0
+		}
+		return bound
+	}
+
+	switch operator {
+	case "", "=":
+		if given == 3 {
+			return version.compare(lower) == 0
+		}
+		return version.compare(lower) >= 0 && version.compare(upper(given - 1)) < 0
+	case ">":
+		if given == 3 {
+			return version.compare(lower) > 0
+		}
+		return version.compare(upper(given - 1)) >= 0
+	case ">=":
+		return version.compare(lower) >= 0
+	case "<":
+		return version.compare(lower) < 0
+	case "<=":
+		if given == 3 {
+			return version.compare(lower) <= 0
+		}
+		return version.compare(upper(given - 1)) < 0
+	case "~":
+		at := 1
+		if given < 2 {
+			at = 0
+		}
+		return version.compare(lower) >= 0 && version.compare(upper(at)) < 0
+	case "^":
+		// Bumps the first non-zero part, so ^1.2.3 is <2.0.0 and ^0.2.3 is <0.3.0.
+		at := 0
+		for at < given - 1 && lower.parts[at] == 0 {
+			at++
+		}
+		return version.compare(lower) >= 0 && version.compare(upper(at)) < 0
+	}
+
+	return false
+}
+
+// Passes for UUIDs in the usual hyphenated form. If versions are given, like "uuid:4" or "uuid:4,7", the UUID must be
+// one of those versions and must have the RFC 4122 variant.
+func (v StringValidityChecker) ValidateUuid(versions ...string) bool {
+	if !uuidExpression.MatchString(v.Item) {
+		return false
+	}
+	if len(versions) == 0 {
+		return true
+	}
+
+	variant := strings.ToLower(v.Item[19:20])
+	if !strings.Contains("89ab", variant) {
+		return false
+	}
+
+	for _, version := range versions {
+		if v.Item[14:15] == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes for ULIDs: 26 characters of Crockford's base 32, which must not overflow 128 bits.
+func (v StringValidityChecker) ValidateUlid() bool {
+	return ulidExpression.MatchString(v.Item)
+}
+
+// Passes for semantic versions, like "1.2.3", "1.0.0-beta.1" or "v2.0.0+build.5".
+func (v StringValidityChecker) ValidateSemver() bool {
+	_, ok := parseSemver(v.Item)
+
+	return ok
+}
+
+// Passes for semantic versions which satisfy every given range, like "semver_range:>=1.2.0 <2.0.0" or "semver_range:^1.2
+// || ^2.0". See satisfiesSemverRange for the syntax.
+func (v StringValidityChecker) ValidateSemverRange(constraints ...string) bool {
+	version, ok := parseSemver(v.Item)
+	if !ok {
+		return false
+	}
+
+	for _, constraint := range constraints {
+		if !satisfiesSemverRange(version, constraint) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Passes for lowercase URL slugs, like "my-first-post".
+func (v StringValidityChecker) ValidateSlug() bool {
+	return slugExpression.MatchString(v.Item)
+}
+
+// Passes for CSS hex colours, like "#fff", "#ffff", "#ffffff" or "#ffffffff".
+func (v StringValidityChecker) ValidateHexColor() bool {
+	return hexColorExpression.MatchString(v.Item)
+}
+
+// Passes for MAC addresses in any of the forms accepted by net.ParseMAC, like "00:00:5e:00:53:01".
+func (v StringValidityChecker) ValidateMacAddress() bool {
+	_, err := net.ParseMAC(v.Item)
+
+	return err == nil
+}
+
+// Passes for RFC 1123 hostnames, like "localhost" or "api.example.com".
+func (v StringValidityChecker) ValidateHostname() bool {
+	return isHostname(v.Item)
+}
+
+// Passes for fully qualified domain names: hostnames with at least two labels, whose top level domain isn't numeric.
+// A trailing dot is allowed.
+func (v StringValidityChecker) ValidateFqdn() bool {
+	if !isHostname(v.Item) {
+		return false
+	}
+
+	labels := strings.Split(strings.TrimSuffix(v.Item, "."), ".")
+	tld    := labels[len(labels)-1]
+
+	return len(labels) >= 2 && strings.Trim(tld, "0123456789") != ""
+}
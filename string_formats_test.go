@@ -0,0 +1,333 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestStringValidateUuidPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "uuid"}}
+
+	for _, value := range []string{
+		"f47ac10b-58cc-4372-a567-0e02b2c3d479", "00000000-0000-0000-0000-000000000000",
+		"F47AC10B-58CC-1372-A567-0E02B2C3D479",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String uuid validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateUuidFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "uuid"}}
+
+	for _, value := range []string{
+		"f47ac10b58cc4372a5670e02b2c3d479", "f47ac10b-58cc-4372-a567-0e02b2c3d47",
+		"g47ac10b-58cc-4372-a567-0e02b2c3d479",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String uuid validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateUuidVersionPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "uuid:4,7"}}
+
+	for _, value := range []string{"f47ac10b-58cc-4372-a567-0e02b2c3d479", "01890a5d-ac96-774b-bcce-b302099a8057"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String uuid validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateUuidVersionFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "uuid:4,7"}}
+
+	for _, value := range []string{"f47ac10b-58cc-1372-a567-0e02b2c3d479", "f47ac10b-58cc-4372-c567-0e02b2c3d479"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String uuid validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateUlidPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "ulid"}}
+
+	for _, value := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String ulid validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateUlidFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "ulid"}}
+
+	for _, value := range []string{
+		"01ARZ3NDEKTSV4RRFFQ69G5FA", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FAU",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String ulid validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateSemverPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver"}}
+
+	for _, value := range []string{
+		"1.2.3", "v1.0.0", "1.0.0-alpha.1", "1.0.0+20130313144700", "1.0.0-beta+exp.sha.5114f85",
+	} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver"}}
+
+	for _, value := range []string{"1.2", "01.2.3", "1.2.3-", "1.2.3-01", "a.b.c"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:>=1.2.0 <2.0.0"}}
+
+	for _, value := range []string{"1.2.0", "1.9.9"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:>=1.2.0 <2.0.0"}}
+
+	for _, value := range []string{"1.1.9", "2.0.0", "nope"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver_range validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCaretPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:^1.2.3"}}
+
+	for _, value := range []string{"1.2.3", "1.9.0"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCaretFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:^1.2.3"}}
+
+	for _, value := range []string{"1.2.2", "2.0.0", "2.0.0-rc.1"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver_range validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCaretZeroPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:^0.2.3"}}
+
+	for _, value := range []string{"0.2.3", "0.2.9"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCaretZeroFail(t *testing.T) {
+	data := TestStruct{Foo: "0.3.0"}
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:^0.2.3"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String semver_range validator does not fail.")
+	}
+}
+func TestStringValidateSemverRangeTildePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:~1.2"}}
+
+	for _, value := range []string{"1.2.0", "1.2.9"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeTildeFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:~1.2"}}
+
+	for _, value := range []string{"1.3.0", "1.1.0"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver_range validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeOrPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:1.x || >=3.0.0-beta"}}
+
+	for _, value := range []string{"1.0.0", "1.5.2", "3.0.0-beta", "3.0.0-beta.2", "4.0.0"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeOrFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:1.x || >=3.0.0-beta"}}
+
+	for _, value := range []string{"2.0.0", "3.0.0-alpha"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver_range validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCommaPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:>1.0,<=2.1"}}
+
+	for _, value := range []string{"1.1.0", "2.1.5"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String semver_range validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSemverRangeCommaFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "semver_range:>1.0,<=2.1"}}
+
+	for _, value := range []string{"1.0.9", "2.2.0"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String semver_range validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateSlugPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "slug"}}
+
+	for _, value := range []string{"my-first-post", "post2"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String slug validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSlugFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "slug"}}
+
+	for _, value := range []string{"My-Post", "my--post", "-post", "post-", "my_post"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String slug validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateHexColorPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "hex_color"}}
+
+	for _, value := range []string{"#fff", "#FFFF", "#a1b2c3", "#a1b2c3d4"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String hex_color validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateHexColorFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "hex_color"}}
+
+	for _, value := range []string{"fff", "#ff", "#fffff", "#ggg"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String hex_color validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateMacAddressPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "mac_address"}}
+
+	for _, value := range []string{"00:00:5e:00:53:01", "00-00-5E-00-53-01", "0000.5e00.5301"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String mac_address validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateMacAddressFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "mac_address"}}
+
+	for _, value := range []string{"00:00:5e:00:53", "00:00:5e:00:53:zz"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String mac_address validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateHostnamePass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "hostname"}}
+
+	for _, value := range []string{"localhost", "api.example.com", "1password.com", "example.com."} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String hostname validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateHostnameFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "hostname"}}
+
+	for _, value := range []string{"-example.com", "exa_mple.com", "example..com", ""} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String hostname validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateFqdnPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "fqdn"}}
+
+	for _, value := range []string{"example.com", "api.example.com."} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String fqdn validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateFqdnFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "fqdn"}}
+
+	for _, value := range []string{"localhost", "example.123", "exa mple.com"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String fqdn validator does not fail %q.", value)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package validity
+
+import (
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// Checks unsigned integers, for the Uint, Uint8, Uint16, Uint32, Uint64 and Uintptr types. It has the same rules as
+// IntValidityChecker, but holds the full range of uint64. Kind is the Go kind the item is given as in the results Data.
+type UintValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  uint64
+	Kind  reflect.Kind
+	// Input is the whole of the data under validation, for rules which depend on other fields.
+	Input map[string]interface{}
+}
+
+// Compares the item to the number in the string, returning -1, 0 or +1 as the item is smaller, equal or larger.
+// Numbers which can't be parsed count as zero, as they do in IntValidityChecker.
+func (v UintValidityChecker) compare(s string) int {
+	other, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		other = big.NewInt(0)
+	}
+
+	return new(big.Int).SetUint64(v.Item).Cmp(other)
+}
+
+// Gets the number of digits from the item. Zero has one digit.
+func (v UintValidityChecker) getDigits() int64 {
+	return int64(len(strconv.FormatUint(v.Item, 10)))
+}
+
+// Converts a string to an integer. That's all there is!
+func (v UintValidityChecker) toInt(s string) int64 {
+	out, _ := strconv.ParseInt(s, 10, 64)
+
+	return out
+}
+
+func (v UintValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v UintValidityChecker) GetItem() interface{} {
+	if kind, ok := sizedTypes[v.Kind]; ok {
+		return reflect.ValueOf(v.Item).Convert(kind).Interface()
+	}
+
+	return v.Item
+}
+
+func (v UintValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v UintValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+func (v UintValidityChecker) ValidateAccepted() bool {
+	return v.Item > 0
+}
+
+func (v UintValidityChecker) ValidateBetween(min string, max string) bool {
+	return v.compare(min) >= 0 && v.compare(max) <= 0
+}
+
+func (v UintValidityChecker) ValidateDigits(num string) bool {
+	return v.getDigits() == v.toInt(num)
+}
+
+func (v UintValidityChecker) ValidateDigitsBetween(min string, max string) bool {
+	digits := v.getDigits()
+
+	return digits >= v.toInt(min) && digits <= v.toInt(max)
+}
+
+func (v UintValidityChecker) ValidateMax(max string) bool {
+	return v.compare(max) <= 0
+}
+
+func (v UintValidityChecker) ValidateMin(min string) bool {
+	return v.compare(min) >= 0
+}
+
+func (v UintValidityChecker) ValidateIn(values ...string) bool {
+	for _, value := range values {
+		if out, err := strconv.ParseUint(value, 10, 64); err == nil && v.Item == out {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v UintValidityChecker) ValidateNotIn(values ...string) bool {
+	return !v.ValidateIn(values...)
+}
+
+func (v UintValidityChecker) ValidateEnum(name string) bool {
+	values, exists := enumValues(name)
+
+	return exists && v.ValidateIn(values...)
+}
@@ -0,0 +1,233 @@
+package validity
+
+import (
+	_ "embed"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rules guarding against text which looks different to what it is: invisible characters, text direction overrides
+// and lookalike characters from other scripts.
+
+//go:embed data/confusables.txt
+var confusablesData string
+
+var (
+	confusablesOnce sync.Once
+	confusables     map[rune]string
+)
+
+// Characters which change the direction text is displayed in, like the right-to-left override, which can make
+// "exe.txt" display as "txt.exe".
+var bidiControls = map[rune]bool{
+	'\u061c': true, '\u200e': true, '\u200f': true, '\u202a': true, '\u202b': true, '\u202c': true, '\u202d': true,
+	'\u202e': true, '\u2066': true, '\u2067': true, '\u2068': true, '\u2069': true,
+}
+
+// Characters which take up no space, so can't be seen.
+var zeroWidth = map[rune]bool{
+	'\u180e': true, '\u200b': true, '\u200c': true, '\u200d': true, '\u2060': true, '\ufeff': true,
+}
+
+func loadConfusables() {
+	confusables = map[rune]string{}
+	for _, fields := range referenceLines(confusablesData) {
+		code, err := strconv.ParseUint(fields[0], 16, 32)
+		if err == nil {
+			confusables[rune(code)] = fields[1]
+		}
+	}
+}
+
+// A script and its table, for searching in order.
+type scriptTable struct {
+	name  string
+	table *unicode.RangeTable
+}
+
+// The scripts runeScript searches, with the most used first so that most text is found after a check or two. The
+// rest follow in name order.
+var scriptOrder = func() []scriptTable {
+	common := []string{"Latin", "Cyrillic", "Greek", "Han", "Hiragana", "Katakana", "Hangul", "Arabic", "Hebrew",
+		"Devanagari", "Thai"}
+
+	order := make([]scriptTable, 0, len(unicode.Scripts))
+	seen  := map[string]bool{"Common": true, "Inherited": true}
+	for _, name := range common {
+		order = append(order, scriptTable{name, unicode.Scripts[name]})
+		seen[name] = true
+	}
+
+	rest := make([]string, 0, len(unicode.Scripts))
+	for name := range unicode.Scripts {
+		if !seen[name] {
+			rest = append(rest, name)
+		}
+	}
+	sort.Strings(rest)
+
+	for _, name := range rest {
+		order = append(order, scriptTable{name, unicode.Scripts[name]})
+	}
+
+	return order
+}()
+
+// Scripts which are written together, so count as one script as well as themselves. These are the sets UTS #39 names
+// Jpan (Japanese), Kore (Korean) and Hanb (Han with Bopomofo).
+var scriptSets = map[string][]string{
+	"Han":      {"Han", "Jpan", "Kore", "Hanb"},
+	"Hiragana": {"Hiragana", "Jpan"},
+	"Katakana": {"Katakana", "Jpan"},
+	"Hangul":   {"Hangul", "Kore"},
+	"Bopomofo": {"Bopomofo", "Hanb"},
+}
+
+// Returns the script the rune is written in, or "" for runes shared between scripts, like digits, punctuation and
+// combining marks.
+func runeScript(r rune) string {
+	if r < utf8.RuneSelf {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return "Latin"
+		}
+		return ""
+	}
+
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return ""
+	}
+
+	for _, script := range scriptOrder {
+		if unicode.Is(script.table, r) {
+			return script.name
+		}
+	}
+
+	return ""
+}
+
+// Returns the scripts the rune counts as: its own, and any set it belongs to, like Jpan for Hiragana.
+func runeScripts(r rune) []string {
+	script := runeScript(r)
+	if script == "" {
+		return nil
+	}
+
+	if set, exists := scriptSets[script]; exists {
+		return set
+	}
+
+	return []string{script}
+}
+
+// Returns the "skeleton" of the string: what it looks like, ignoring case. Strings with the same skeleton are likely to
+// be mistaken for each other, like "paypal" and "p\u0430ypal" (with a Cyrillic "\u0430"), or "admin" and "adm1n". Invisible
+// characters are removed, fullwidth forms become ASCII, and characters which look like ASCII letters are replaced by
+// them. This follows UTS #39, but with a much smaller table and case folding, so it suits names rather than
+// arbitrary text.
+func Skeleton(s string) string {
+	confusablesOnce.Do(loadConfusables)
+
+	out := strings.Builder{}
+	for _, r := range s {
+		switch {
+		case bidiControls[r] || zeroWidth[r] || unicode.Is(unicode.Variation_Selector, r):
+			continue
+		case r >= '\uff01' && r <= '\uff5e':
+			r -= 0xfee0
+		}
+
+		if mapped, ok := confusables[r]; ok {
+			out.WriteString(mapped)
+		} else {
+			out.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return strings.NewReplacer("rn", "m", "vv", "w").Replace(out.String())
+}
+
+// Returns whether the two strings look alike, by comparing their skeletons. Identical strings are confusable too.
+func Confusable(a, b string) bool {
+	return Skeleton(a) == Skeleton(b)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes if there are no control characters, like null bytes, escapes or newlines.
+func (v StringValidityChecker) ValidateNoControlChars() bool {
+	return strings.IndexFunc(v.Item, unicode.IsControl) == -1
+}
+
+// Passes if there are no characters overriding the direction of text.
+func (v StringValidityChecker) ValidateNoBidiControls() bool {
+	return strings.IndexFunc(v.Item, func(r rune) bool { return bidiControls[r] }) == -1
+}
+
+// Passes if there are no zero width characters.
+func (v StringValidityChecker) ValidateNoZeroWidth() bool {
+	return strings.IndexFunc(v.Item, func(r rune) bool { return zeroWidth[r] }) == -1
+}
+
+// Passes if every letter is from the same script, like "Latin" or "Cyrillic". Characters shared by scripts, like
+// digits and punctuation, are allowed with any of them. As in UTS #39, Japanese (Han, Hiragana and Katakana, named
+// Jpan), Korean (Han and Hangul, named Kore) and Han with Bopomofo (named Hanb) each count as one script. If scripts
+// are given, like "single_script:Latin,Jpan", the script must be one of them.
+func (v StringValidityChecker) ValidateSingleScript(scripts ...string) bool {
+	var found []string
+
+	for _, r := range v.Item {
+		current := runeScripts(r)
+		if current == nil {
+			continue
+		}
+		if found == nil {
+			found = current
+			continue
+		}
+
+		// Only the scripts every letter so far counts as are kept, so Han then Hiragana leaves just Jpan.
+		kept := []string{}
+		for _, script := range found {
+			if inSlice(script, current) {
+				kept = append(kept, script)
+			}
+		}
+		if len(kept) == 0 {
+			return false
+		}
+		found = kept
+	}
+
+	if found == nil || len(scripts) == 0 {
+		return true
+	}
+
+	for _, script := range scripts {
+		if inSlice(script, found) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the value doesn't look like the value of any of the given fields, as decided by Confusable. It's meant for
+// values which shouldn't be the same, like a display name and an old display name. To stop users impersonating
+// existing names, compare skeletons instead: store the Skeleton of each name, and check new names against them.
+func (v StringValidityChecker) ValidateNotConfusableWith(fields ...string) bool {
+	for _, field := range fields {
+		other, exists := lookupPath(v.Input, field)
+		if str, ok := other.(string); exists && ok && Confusable(v.Item, str) {
+			return false
+		}
+	}
+
+	return true
+}
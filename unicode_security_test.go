@@ -0,0 +1,204 @@
+package validity
+
+import (
+	"testing"
+)
+
+func TestStringValidateNoControlCharsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_control_chars"}}
+
+	for _, value := range []string{"connor", "José", "日本語"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String no_control_chars validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNoControlCharsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_control_chars"}}
+
+	for _, value := range []string{"con\x00nor", "con\nnor", "\x1b[31mred", "con\u0085nor"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String no_control_chars validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateNoBidiControlsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_bidi_controls"}}
+
+	for _, value := range []string{"connor", "שלום"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String no_bidi_controls validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNoBidiControlsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_bidi_controls"}}
+
+	for _, value := range []string{"invoice\u202efdp.exe", "\u2067abc\u2069", "a\u200fb"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String no_bidi_controls validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateNoZeroWidthPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_zero_width"}}
+
+	for _, value := range []string{"connor", "\U0001F468"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String no_zero_width validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateNoZeroWidthFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "no_zero_width"}}
+
+	for _, value := range []string{"con\u200bnor", "\ufeffconnor", "con\u2060nor"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String no_zero_width validator does not fail %q.", value)
+		}
+	}
+}
+
+
+
+func TestStringValidateSingleScriptPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "single_script"}}
+
+	for _, value := range []string{"connor", "connor_42!", "привет", "José", "1234", ""} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String single_script validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSingleScriptFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "single_script"}}
+
+	for _, value := range []string{"p\u0430ypal", "\u03b1bc", "abc\u65e5"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String single_script validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSingleScriptNamedPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "single_script:Latin"}}
+
+	for _, value := range []string{"connor", "123"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String single_script validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSingleScriptNamedFail(t *testing.T) {
+	data := TestStruct{Foo: "привет"}
+	rules := ValidationRules{"Foo": []string{"String", "single_script:Latin"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String single_script validator does not fail.")
+	}
+}
+func TestStringValidateSingleScriptSetsPass(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "single_script"}}
+
+	for _, value := range []string{"日本語です", "カタカナと漢字", "한국어 韓國語", "注音ㄅㄆㄇ"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if !results.IsValid {
+			t.Errorf("String single_script validator does not pass %q.", value)
+		}
+	}
+}
+func TestStringValidateSingleScriptSetsFail(t *testing.T) {
+	rules := ValidationRules{"Foo": []string{"String", "single_script"}}
+
+	for _, value := range []string{"ひらがな한국어", "漢字abc", "ㄅㄆㄇかな"} {
+		results := ValidateStruct(TestStruct{Foo: value}, rules)
+		if results.IsValid {
+			t.Errorf("String single_script validator does not fail %q.", value)
+		}
+	}
+}
+func TestStringValidateSingleScriptNamedSetPass(t *testing.T) {
+	data := TestStruct{Foo: "日本語です"}
+	rules := ValidationRules{"Foo": []string{"String", "single_script:Jpan"}}
+
+	results := ValidateStruct(data, rules)
+	if !results.IsValid {
+		t.Errorf("String single_script validator does not pass.")
+	}
+}
+func TestStringValidateSingleScriptNamedSetFail(t *testing.T) {
+	data := TestStruct{Foo: "日本語です"}
+	rules := ValidationRules{"Foo": []string{"String", "single_script:Han"}}
+
+	results := ValidateStruct(data, rules)
+	if results.IsValid {
+		t.Errorf("String single_script validator does not fail.")
+	}
+}
+
+
+
+func TestSkeleton(t *testing.T) {
+	tests := map[string]string{
+		"paypal":                          "paypal",
+		"p\u0430yp\u0430l":                "paypal",
+		"PayPal":                          "paypal",
+		"adm1n":                           "admln",
+		"admIn":                           "admln",
+		"\uff41\uff44\uff4d\uff49\uff4e":    "admin",
+		"con\u200bnor":                    "connor",
+		"rnodern":                         "modem",
+		"\u0455\u0441\u043e\u0440\u0435":    "scope",
+	}
+
+	for input, expected := range tests {
+		if actual := Skeleton(input); actual != expected {
+			t.Errorf("Skeleton of %q should be %q, got %q.", input, expected, actual)
+		}
+	}
+}
+
+
+
+func TestConfusable(t *testing.T) {
+	if !Confusable("paypal", "p\u0430yp\u0430l") || !Confusable("google", "g00gle") || !Confusable("Admin", "admin") {
+		t.Errorf("Confusable strings were not detected.")
+	}
+
+	if Confusable("paypal", "paypa") || Confusable("admin", "admins") {
+		t.Errorf("Different strings were detected as confusable.")
+	}
+}
+
+
+
+func TestStringValidateNotConfusableWith(t *testing.T) {
+	data := map[string]interface{}{
+		"owner": "connor",
+		"a":     "c\u043enn\u043er",
+		"b":     "C0nn0r",
+		"c":     "conner",
+	}
+	rule  := "not_confusable_with:owner"
+	rules := ValidationRules{"a": []string{"String", rule}, "b": []string{"String", rule}, "c": []string{"String", rule}}
+
+	results := ValidateMap(data, rules)
+	if len(results.Errors["a"]) != 1 || len(results.Errors["b"]) != 1 || len(results.Errors["c"]) != 0 {
+		t.Errorf("String not_confusable_with validator does not work. Errors: %v", results.Errors)
+	}
+}
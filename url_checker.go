@@ -0,0 +1,143 @@
+package validity
+
+import (
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+type URLValidityChecker struct {
+	Key   string
+	Rules []string
+	Item  *url.URL
+}
+
+// Address ranges which are not reachable on the public internet, on top of those netip already knows about (loopback,
+// private, link-local, multicast and unspecified addresses).
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	// IPv4-compatible addresses, which embed an IPv4 address without the ::ffff: prefix that Unmap removes.
+	netip.MustParsePrefix("::/96"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/32"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("2002::/16"),
+}
+
+// Returns whether the address is reachable on the public internet. IPv4 addresses mapped into IPv6 are checked as the
+// IPv4 address they contain.
+func isPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() || addr.IsMulticast() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() {
+		return false
+	}
+
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v URLValidityChecker) GetKey() string {
+	return v.Key
+}
+
+func (v URLValidityChecker) GetItem() interface{} {
+	return v.Item
+}
+
+func (v URLValidityChecker) GetRules() []string {
+	return v.Rules
+}
+
+func (v URLValidityChecker) GetErrors() []string {
+	return GetCheckerErrors(v.Rules[1:], &v)
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+// For explanation involving validation rules, checkout the first huge comment in validity.go.
+//----------------------------------------------------------------------------------------------------------------------
+
+// Passes if the URL has both a scheme and a host, so "/relative" and "javascript:alert(1)" both fail.
+func (v URLValidityChecker) ValidateAbsolute() bool {
+	return v.Item.Scheme != "" && v.Item.Host != ""
+}
+
+func (v URLValidityChecker) ValidateNoUserinfo() bool {
+	return v.Item.User == nil
+}
+
+func (v URLValidityChecker) ValidateUrlScheme(schemes ...string) bool {
+	for _, scheme := range schemes {
+		if strings.EqualFold(v.Item.Scheme, scheme) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the URL's host is one of those given. A host starting with "*." matches any subdomain, but not the domain
+// itself, so "*.example.com" allows "api.example.com" but not "example.com" or "badexample.com".
+func (v URLValidityChecker) ValidateUrlHostIn(hosts ...string) bool {
+	hostname := strings.ToLower(strings.TrimSuffix(v.Item.Hostname(), "."))
+
+	for _, host := range hosts {
+		host = strings.ToLower(host)
+
+		if strings.HasPrefix(host, "*.") {
+			if strings.HasSuffix(hostname, host[1:]) {
+				return true
+			}
+		} else if hostname == host {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Passes if the URL is absolute and every address its host resolves to, through URLResolver, is on the public
+// internet. This protects against server-side request forgery, but note that DNS may give a different answer when the
+// request is actually made, so connections should also be checked when they're dialed if that matters to you.
+func (v URLValidityChecker) ValidatePublicUrl() bool {
+	if !v.ValidateAbsolute() {
+		return false
+	}
+
+	hostname := v.Item.Hostname()
+	if addr, err := netip.ParseAddr(hostname); err == nil {
+		return isPublicAddr(addr)
+	}
+
+	ctx, cancel := lookupContext()
+	defer cancel()
+
+	addrs, err := URLResolver.LookupIPAddr(ctx, hostname)
+	if err != nil || len(addrs) == 0 {
+		return false
+	}
+
+	for _, ip := range addrs {
+		addr, ok := netip.AddrFromSlice(ip.IP)
+		if !ok || !isPublicAddr(addr) {
+			return false
+		}
+	}
+
+	return true
+}
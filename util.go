@@ -61,3 +61,39 @@ func inSlice(a string, list []string) bool {
 	}
 	return false
 }
+
+// Looks up a dotted path, like "address.city", in the data. Each part except the last must point to a nested
+// map[string]interface{}, which is what you get from decoding JSON or from structs.Map on nested structs. A key which
+// has the dots in it literally, like {"a.b": 1}, is found first.
+func lookupPath(data map[string]interface{}, path string) (interface{}, bool) {
+	if item, exists := data[path]; exists {
+		return item, true
+	}
+
+	parts := strings.Split(path, ".")
+
+	for _, part := range parts[:len(parts)-1] {
+		nested, ok := data[part].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		data = nested
+	}
+
+	item, exists := data[parts[len(parts)-1]]
+	return item, exists
+}
+
+// Finds the first rule with the given name, such as "default", and returns everything after its colon with the
+// surrounding spaces trimmed. Unlike parseRule, the argument is not split on commas.
+func findRuleArgument(name string, rules []string) (string, bool) {
+	for _, rule := range rules {
+		parts := strings.SplitN(rule, ":", 2)
+
+		if len(parts) == 2 && strings.ToLower(strings.Trim(parts[0], " ")) == name {
+			return strings.Trim(parts[1], " "), true
+		}
+	}
+
+	return "", false
+}
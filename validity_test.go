@@ -17,6 +17,11 @@ type TestStructTags struct {
 	Baz float32
 }
 
+type TestStructDefaults struct {
+	Foo string	`default:"bar"`
+	Bar int		`validators:"min:5" default:"10"`
+}
+
 func TestValidatesMap(t *testing.T) {
 	var v interface {}
 	v = "42"
@@ -50,7 +55,7 @@ func TestValidatesStructTags(t *testing.T) {
 		results.Errors["Foo"][0] != "Between" ||
 		len(results.Errors["Foo"]) != 2 ||
 		len(results.Errors["Bar"]) != 0 {
-		t.Errorf("Does not validate a basic struct of data! Results: %s", results)
+		t.Errorf("Does not validate a basic struct of data! Results: %v", results)
 	}
 }
 
@@ -140,3 +145,121 @@ func TestReturnsProperResultsOnSuccess(t *testing.T) {
 		t.Errorf("Validator should return data which which passed.")
 	}
 }
+
+
+
+func TestValidatesNestedKeys(t *testing.T) {
+	data := map[string]interface{}{"address": map[string]interface{}{"zip": "12345"}}
+	rules := ValidationRules{"address.zip": []string{"Int", "digits:5"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["address.zip"] != int64(12345) {
+		t.Errorf("Does not validate nested keys! Results: %v", results)
+	}
+}
+
+func TestValidatesDottedKeys(t *testing.T) {
+	data := map[string]interface{}{"a.b": "x", "c": map[string]interface{}{"d": "y"}}
+	rules := ValidationRules{"a.b": []string{"String", "required"}, "c.d": []string{"String", "required"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["a.b"] != "x" || results.Data["c.d"] != "y" {
+		t.Errorf("Does not find keys with dots in them! Results: %v", results)
+	}
+}
+
+func TestIgnoresUnknownByDefault(t *testing.T) {
+	data := map[string]interface{}{"foo": "42", "bar": "baz"}
+	rules := ValidationRules{"foo": []string{"Int"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid {
+		t.Errorf("Unknown keys should be ignored by default!")
+	}
+	if _, exists := results.Data["bar"]; exists {
+		t.Errorf("Unknown keys should not be in the data by default!")
+	}
+}
+
+func TestRejectsUnknown(t *testing.T) {
+	data := map[string]interface{}{
+		"foo": "42",
+		"bar": "baz",
+		"address": map[string]interface{}{"zip": "12345", "evil": true},
+	}
+	rules := ValidationRules{"foo": []string{"Int"}, "address.zip": []string{"Int"}}
+
+	results := ValidateMapWithOptions(data, rules, ValidationOptions{UnknownKeys: RejectUnknown})
+	if results.IsValid {
+		t.Errorf("Unknown keys should be rejected!")
+	}
+	if len(results.Errors["bar"]) != 1 || results.Errors["bar"][0] != "Unknown" {
+		t.Errorf("Unknown key was not reported. Errors: %v", results.Errors)
+	}
+	if len(results.Errors["address.evil"]) != 1 {
+		t.Errorf("Nested unknown key was not reported. Errors: %v", results.Errors)
+	}
+	if len(results.Errors) != 2 {
+		t.Errorf("Known keys were reported as unknown. Errors: %v", results.Errors)
+	}
+}
+
+func TestPassesThroughUnknown(t *testing.T) {
+	data := map[string]interface{}{"foo": "42", "bar": "baz", "meta": map[string]interface{}{"a": 1}}
+	rules := ValidationRules{"foo": []string{"Int"}}
+
+	results := ValidateMapWithOptions(data, rules, ValidationOptions{UnknownKeys: PassthroughUnknown})
+	if !results.IsValid {
+		t.Errorf("Unknown keys should not be errors when passed through! Errors: %v", results.Errors)
+	}
+	if results.Data["foo"] != int64(42) || results.Data["bar"] != "baz" {
+		t.Errorf("Unknown keys were not passed through. Data: %v", results.Data)
+	}
+	if _, ok := results.Data["meta"].(map[string]interface{}); !ok {
+		t.Errorf("Unknown nested maps were not passed through whole. Data: %v", results.Data)
+	}
+}
+
+
+
+func TestAppliesDefaults(t *testing.T) {
+	data := map[string]interface{}{}
+	rules := ValidationRules{"foo": []string{"Int", "default:42", "min:5"}, "bar": []string{"String", "default: a, b"}}
+
+	results := ValidateMap(data, rules)
+	if !results.IsValid || results.Data["foo"] != int64(42) || results.Data["bar"] != "a, b" {
+		t.Errorf("Defaults were not applied and converted. Results: %v", results)
+	}
+}
+
+func TestDefaultsAreValidated(t *testing.T) {
+	data := map[string]interface{}{}
+	rules := ValidationRules{"foo": []string{"Int", "default:nope"}}
+
+	results := ValidateMap(data, rules)
+	if results.IsValid || results.Errors["foo"][0] != "Int" {
+		t.Errorf("Invalid defaults should fail validation. Results: %v", results)
+	}
+}
+
+func TestDefaultsDoNotReplaceValues(t *testing.T) {
+	data := map[string]interface{}{"foo": "7"}
+	rules := ValidationRules{"foo": []string{"Int", "default:42"}}
+
+	results := ValidateMap(data, rules)
+	if results.Data["foo"] != int64(7) {
+		t.Errorf("Defaults should not replace given values. Data: %v", results.Data)
+	}
+}
+
+func TestAppliesDefaultTags(t *testing.T) {
+	results := ValidateStructTags(TestStructDefaults{})
+	if !results.IsValid || results.Data["Foo"] != "bar" || results.Data["Bar"] != int64(10) {
+		t.Errorf("Default tags were not applied. Results: %v", results)
+	}
+
+	results = ValidateStructTags(TestStructDefaults{Foo: "baz", Bar: 6})
+	if !results.IsValid || results.Data["Foo"] != "baz" || results.Data["Bar"] != int64(6) {
+		t.Errorf("Default tags should not replace set fields. Results: %v", results)
+	}
+}